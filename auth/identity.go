@@ -0,0 +1,31 @@
+// Package auth authenticates gRPC callers from bearer tokens and carries their identity
+// in the request context.
+package auth
+
+import "context"
+
+// RoleAdmin is the token role allowed to act on behalf of other users.
+const RoleAdmin = "admin"
+
+// Identity is the authenticated caller of a request.
+type Identity struct {
+	UserID string
+	Role   string
+}
+
+func (id *Identity) IsAdmin() bool {
+	return id.Role == RoleAdmin
+}
+
+type identityKey struct{}
+
+// NewContext returns a copy of ctx carrying the caller's identity.
+func NewContext(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// FromContext returns the caller's identity, if the request was authenticated.
+func FromContext(ctx context.Context) (*Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(*Identity)
+	return id, ok
+}
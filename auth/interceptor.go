@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor authenticates each call from the bearer token in its
+// authorization metadata and stores the caller's identity in the context. Methods in
+// public may be called without a token; a token sent to them is still verified.
+func UnaryServerInterceptor(v *Verifier, public map[string]bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		token, err := bearerToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if token == "" {
+			if public[info.FullMethod] {
+				return handler(ctx, req)
+			}
+			return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+		}
+
+		id, err := v.Verify(token)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid bearer token: %v", err)
+		}
+		return handler(NewContext(ctx, id), req)
+	}
+}
+
+// bearerToken returns the token from the authorization metadata, or "" if none was sent.
+func bearerToken(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", nil
+	}
+
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") || strings.TrimSpace(token) == "" {
+		return "", status.Error(codes.Unauthenticated, "authorization metadata must be a bearer token")
+	}
+	return strings.TrimSpace(token), nil
+}
@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Projects/ComunityService/config"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	const (
+		publicMethod  = "/CommunityServer.CommunityService/GetCommunityBy"
+		privateMethod = "/CommunityServer.CommunityService/JoinCommunity"
+	)
+	v, err := NewVerifier(config.AuthConfig{JWTSecrets: []string{testSecret}})
+	if err != nil {
+		t.Fatalf("NewVerifier: %v", err)
+	}
+	interceptor := UnaryServerInterceptor(v, map[string]bool{publicMethod: true})
+
+	good := "Bearer " + sign(t, jwt.SigningMethodHS256, []byte(testSecret), testClaims())
+	bad := "Bearer " + sign(t, jwt.SigningMethodHS256, []byte(testOtherSecret), testClaims())
+
+	tests := []struct {
+		name          string
+		method        string
+		authorization string // empty sends no authorization metadata
+		want          codes.Code
+		wantUser      string // empty when the handler should see no identity
+	}{
+		{name: "public without a token", method: publicMethod, want: codes.OK},
+		{name: "public with a bad token", method: publicMethod, authorization: bad, want: codes.Unauthenticated},
+		{name: "public with a good token", method: publicMethod, authorization: good, want: codes.OK, wantUser: "u1"},
+		{name: "private without a token", method: privateMethod, want: codes.Unauthenticated},
+		{name: "private with a bad token", method: privateMethod, authorization: bad, want: codes.Unauthenticated},
+		{name: "private with a good token", method: privateMethod, authorization: good, want: codes.OK, wantUser: "u1"},
+		{name: "lowercase scheme", method: privateMethod, authorization: "bearer" + good[len("Bearer"):], want: codes.OK, wantUser: "u1"},
+		{name: "not a bearer token", method: publicMethod, authorization: "Basic dTE6cGFzc3dvcmQ=", want: codes.Unauthenticated},
+		{name: "empty bearer token", method: publicMethod, authorization: "Bearer ", want: codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.authorization))
+			}
+
+			called := false
+			var gotUser string
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				if id, ok := FromContext(ctx); ok {
+					gotUser = id.UserID
+				}
+				return "ok", nil
+			}
+
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("code = %s (%v), want %s", got, err, tt.want)
+			}
+			if called != (tt.want == codes.OK) {
+				t.Errorf("handler called = %v", called)
+			}
+			if gotUser != tt.wantUser {
+				t.Errorf("handler saw user %q, want %q", gotUser, tt.wantUser)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/Projects/ComunityService/config"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// claims are the token claims the service reads. The user ID is taken from sub, falling
+// back to user_id for tokens that only set that.
+type claims struct {
+	jwt.RegisteredClaims
+	UserID string `json:"user_id"`
+	Role   string `json:"role"`
+}
+
+// Verifier validates signed JWTs against the keys from the config.
+type Verifier struct {
+	hmacKeys  []jwt.VerificationKey
+	publicKey jwt.VerificationKey
+	parser    *jwt.Parser
+}
+
+// MinSecretLength is the shortest HMAC key accepted, in bytes. Shorter keys can be brute
+// forced offline from a single token.
+const MinSecretLength = 32
+
+func NewVerifier(cfg config.AuthConfig) (*Verifier, error) {
+	v := &Verifier{}
+	var methods []string
+
+	for i, secret := range cfg.JWTSecrets {
+		if len(secret) < MinSecretLength {
+			return nil, fmt.Errorf("JWT secret %d is %d bytes, need at least %d", i+1, len(secret), MinSecretLength)
+		}
+		v.hmacKeys = append(v.hmacKeys, []byte(secret))
+	}
+	if len(v.hmacKeys) > 0 {
+		methods = append(methods, "HS256", "HS384", "HS512")
+	}
+
+	if cfg.JWTPublicKeyFile != "" {
+		pem, err := os.ReadFile(cfg.JWTPublicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read JWT public key: %w", err)
+		}
+		if key, err := jwt.ParseRSAPublicKeyFromPEM(pem); err == nil {
+			v.publicKey = key
+			methods = append(methods, "RS256", "RS384", "RS512", "PS256", "PS384", "PS512")
+		} else if key, err := jwt.ParseECPublicKeyFromPEM(pem); err == nil {
+			v.publicKey = key
+			methods = append(methods, "ES256", "ES384", "ES512")
+		} else if key, err := jwt.ParseEdPublicKeyFromPEM(pem); err == nil {
+			v.publicKey = key
+			methods = append(methods, "EdDSA")
+		} else {
+			return nil, fmt.Errorf("JWT public key %s is not an RSA, ECDSA or Ed25519 key", cfg.JWTPublicKeyFile)
+		}
+	}
+
+	if len(methods) == 0 {
+		return nil, errors.New("no JWT keys configured, set JWT_SECRETS or JWT_PUBLIC_KEY_FILE")
+	}
+
+	opts := []jwt.ParserOption{jwt.WithValidMethods(methods), jwt.WithExpirationRequired()}
+	if cfg.JWTIssuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.JWTIssuer))
+	}
+	v.parser = jwt.NewParser(opts...)
+	return v, nil
+}
+
+// Verify checks the token's signature and expiry and returns the identity it carries.
+func (v *Verifier) Verify(token string) (*Identity, error) {
+	var c claims
+	_, err := v.parser.ParseWithClaims(token, &c, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); ok {
+			return jwt.VerificationKeySet{Keys: v.hmacKeys}, nil
+		}
+		return v.publicKey, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	userID := c.Subject
+	if userID == "" {
+		userID = c.UserID
+	}
+	if userID == "" {
+		return nil, errors.New("token has no subject")
+	}
+
+	return &Identity{UserID: userID, Role: c.Role}, nil
+}
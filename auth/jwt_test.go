@@ -0,0 +1,263 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Projects/ComunityService/config"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	testSecret      = "0123456789abcdef0123456789abcdef"
+	testOtherSecret = "fedcba9876543210fedcba9876543210"
+)
+
+// testClaims builds claims for user u1 that expire in an hour.
+func testClaims() jwt.MapClaims {
+	return jwt.MapClaims{"sub": "u1", "exp": time.Now().Add(time.Hour).Unix()}
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, key interface{}, c jwt.MapClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(method, c).SignedString(key)
+	if err != nil {
+		t.Fatalf("SignedString: %v", err)
+	}
+	return token
+}
+
+// writePublicKey writes the key's PEM to a temporary file and returns its path and contents.
+func writePublicKey(t *testing.T, key *rsa.PrivateKey) (string, []byte) {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("MarshalPKIXPublicKey: %v", err)
+	}
+	data := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	path := filepath.Join(t.TempDir(), "jwt.pub")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	return path, data
+}
+
+func TestNewVerifier(t *testing.T) {
+	dir := t.TempDir()
+	notAKey := filepath.Join(dir, "not-a-key.pem")
+	if err := os.WriteFile(notAKey, []byte("hello"), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		cfg     config.AuthConfig
+		wantErr string
+	}{
+		{name: "secret of 32 bytes", cfg: config.AuthConfig{JWTSecrets: []string{testSecret}}},
+		{name: "short secret", cfg: config.AuthConfig{JWTSecrets: []string{testSecret[:31]}}, wantErr: "JWT secret 1 is 31 bytes, need at least 32"},
+		{name: "short second secret", cfg: config.AuthConfig{JWTSecrets: []string{testSecret, "secret"}}, wantErr: "JWT secret 2 is 6 bytes"},
+		{name: "empty secret", cfg: config.AuthConfig{JWTSecrets: []string{""}}, wantErr: "JWT secret 1 is 0 bytes"},
+		{name: "no keys", wantErr: "no JWT keys configured"},
+		{name: "missing public key file", cfg: config.AuthConfig{JWTPublicKeyFile: filepath.Join(dir, "missing.pem")}, wantErr: "failed to read JWT public key"},
+		{name: "public key file without a key", cfg: config.AuthConfig{JWTPublicKeyFile: notAKey}, wantErr: "is not an RSA, ECDSA or Ed25519 key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewVerifier(tt.cfg)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("NewVerifier: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("NewVerifier error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	publicKeyFile, publicKeyPEM := writePublicKey(t, rsaKey)
+
+	hmacOnly := config.AuthConfig{JWTSecrets: []string{testSecret}}
+	rotated := config.AuthConfig{JWTSecrets: []string{testOtherSecret, testSecret}}
+	withIssuer := config.AuthConfig{JWTSecrets: []string{testSecret}, JWTIssuer: "garden-auth"}
+	publicOnly := config.AuthConfig{JWTPublicKeyFile: publicKeyFile}
+
+	with := func(update func(c jwt.MapClaims)) jwt.MapClaims {
+		c := testClaims()
+		update(c)
+		return c
+	}
+
+	tests := []struct {
+		name     string
+		cfg      config.AuthConfig
+		token    func(t *testing.T) string
+		wantUser string
+		wantRole string
+		wantErr  string
+	}{
+		{
+			name:     "HS256 token",
+			cfg:      hmacOnly,
+			token:    func(t *testing.T) string { return sign(t, jwt.SigningMethodHS256, []byte(testSecret), testClaims()) },
+			wantUser: "u1",
+		},
+		{
+			name: "role is carried",
+			cfg:  hmacOnly,
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodHS512, []byte(testSecret), with(func(c jwt.MapClaims) { c["role"] = RoleAdmin }))
+			},
+			wantUser: "u1",
+			wantRole: RoleAdmin,
+		},
+		{
+			name:     "second key of a rotation",
+			cfg:      rotated,
+			token:    func(t *testing.T) string { return sign(t, jwt.SigningMethodHS256, []byte(testSecret), testClaims()) },
+			wantUser: "u1",
+		},
+		{
+			name: "unknown key",
+			cfg:  hmacOnly,
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodHS256, []byte(testOtherSecret), testClaims())
+			},
+			wantErr: "signature is invalid",
+		},
+		{
+			name: "user_id when sub is missing",
+			cfg:  hmacOnly,
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodHS256, []byte(testSecret), with(func(c jwt.MapClaims) { delete(c, "sub"); c["user_id"] = "u2" }))
+			},
+			wantUser: "u2",
+		},
+		{
+			name: "sub wins over user_id",
+			cfg:  hmacOnly,
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodHS256, []byte(testSecret), with(func(c jwt.MapClaims) { c["user_id"] = "u2" }))
+			},
+			wantUser: "u1",
+		},
+		{
+			name: "no subject",
+			cfg:  hmacOnly,
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodHS256, []byte(testSecret), with(func(c jwt.MapClaims) { delete(c, "sub") }))
+			},
+			wantErr: "token has no subject",
+		},
+		{
+			name: "alg none",
+			cfg:  hmacOnly,
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, testClaims())
+			},
+			wantErr: "signing method none is invalid",
+		},
+		{
+			name:    "RS256 token when only HMAC keys are configured",
+			cfg:     hmacOnly,
+			token:   func(t *testing.T) string { return sign(t, jwt.SigningMethodRS256, rsaKey, testClaims()) },
+			wantErr: "signing method RS256 is invalid",
+		},
+		{
+			name:     "RS256 token",
+			cfg:      publicOnly,
+			token:    func(t *testing.T) string { return sign(t, jwt.SigningMethodRS256, rsaKey, testClaims()) },
+			wantUser: "u1",
+		},
+		{
+			// The public key is not a secret, so it must never verify an HMAC signature.
+			name:    "HS256 token signed with the public key",
+			cfg:     publicOnly,
+			token:   func(t *testing.T) string { return sign(t, jwt.SigningMethodHS256, publicKeyPEM, testClaims()) },
+			wantErr: "signing method HS256 is invalid",
+		},
+		{
+			name: "expired",
+			cfg:  hmacOnly,
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodHS256, []byte(testSecret), with(func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() }))
+			},
+			wantErr: "token is expired",
+		},
+		{
+			name: "no exp",
+			cfg:  hmacOnly,
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodHS256, []byte(testSecret), with(func(c jwt.MapClaims) { delete(c, "exp") }))
+			},
+			wantErr: "exp claim is required",
+		},
+		{
+			name: "issuer matches",
+			cfg:  withIssuer,
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodHS256, []byte(testSecret), with(func(c jwt.MapClaims) { c["iss"] = "garden-auth" }))
+			},
+			wantUser: "u1",
+		},
+		{
+			name: "issuer mismatch",
+			cfg:  withIssuer,
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodHS256, []byte(testSecret), with(func(c jwt.MapClaims) { c["iss"] = "someone-else" }))
+			},
+			wantErr: "token has invalid issuer",
+		},
+		{
+			name:    "issuer missing",
+			cfg:     withIssuer,
+			token:   func(t *testing.T) string { return sign(t, jwt.SigningMethodHS256, []byte(testSecret), testClaims()) },
+			wantErr: "iss claim is required",
+		},
+		{
+			name:    "not a token",
+			cfg:     hmacOnly,
+			token:   func(t *testing.T) string { return "not-a-token" },
+			wantErr: "token is malformed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := NewVerifier(tt.cfg)
+			if err != nil {
+				t.Fatalf("NewVerifier: %v", err)
+			}
+
+			id, err := v.Verify(tt.token(t))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Verify error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify: %v", err)
+			}
+			if id.UserID != tt.wantUser || id.Role != tt.wantRole {
+				t.Errorf("identity = %+v, want user %q with role %q", id, tt.wantUser, tt.wantRole)
+			}
+		})
+	}
+}
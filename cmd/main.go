@@ -4,21 +4,25 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"net/http"
 
+	"github.com/Projects/ComunityService/auth"
 	"github.com/Projects/ComunityService/config"
 	pb "github.com/Projects/ComunityService/genproto/CommunityService"
+	garden "github.com/Projects/ComunityService/genproto/GardenManagementService"
 	user "github.com/Projects/ComunityService/genproto/UserManagementService"
 
 	"github.com/Projects/ComunityService/services"
+	"github.com/Projects/ComunityService/storage"
+	"github.com/Projects/ComunityService/storage/memory"
+	"github.com/Projects/ComunityService/storage/postgres"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
-func GetDB(path string) (*sqlx.DB, error) {
-	cfg := config.Load(path)
-
+func GetDB(cfg config.Config) (*sqlx.DB, error) {
 	psqlUrl := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
 		cfg.Postgres.DbHost,
 		cfg.Postgres.DbPort,
@@ -37,11 +41,28 @@ func main() {
 		log.Fatal("Failed to listen: ", err)
 	}
 
-	grpcServer := grpc.NewServer()
-	db, err := GetDB(".")
+	cfg := config.Load(".")
 
+	verifier, err := auth.NewVerifier(cfg.Auth)
 	if err != nil {
-		log.Fatalf("Connecting to database failed: %v", err)
+		log.Fatalf("Loading JWT keys failed: %v", err)
+	}
+	if len(cfg.Auth.InviteSecret) < auth.MinSecretLength {
+		log.Fatalf("INVITE_SECRET must be at least %d bytes, it signs community invite codes", auth.MinSecretLength)
+	}
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(auth.UnaryServerInterceptor(verifier, services.PublicMethods)))
+
+	var st storage.IStorage
+	switch cfg.Storage.Driver {
+	case "memory":
+		log.Println("Using in-memory storage, data is lost on restart")
+		st = memory.NewStorage()
+	default:
+		db, err := GetDB(cfg)
+		if err != nil {
+			log.Fatalf("Connecting to database failed: %v", err)
+		}
+		st = postgres.NewStorage(db)
 	}
 
 	conn, err := grpc.NewClient("localhost:50052", grpc.WithTransportCredentials(insecure.NewCredentials()))
@@ -51,9 +72,26 @@ func main() {
 	defer conn.Close()
 	userClient := user.NewUserManagementServiceClient(conn)
 
-	communityService := services.NewCommunityService(db, userClient)
+	gardenConn, err := grpc.NewClient("localhost:50053", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to Garden Management Service: %v", err)
+	}
+	defer gardenConn.Close()
+	gardenClient := garden.NewGardenManagementServiceClient(gardenConn)
+
+	communityService := services.NewCommunityService(st, userClient, gardenClient, []byte(cfg.Auth.InviteSecret))
 	pb.RegisterCommunityServiceServer(grpcServer, communityService)
 
+	forumService := services.NewForumService(st, userClient)
+	pb.RegisterForumServiceServer(grpcServer, forumService)
+
+	go func() {
+		log.Printf("Calendar HTTP server is running on port %s", cfg.Server.HTTPPort)
+		if err := http.ListenAndServe(":"+cfg.Server.HTTPPort, services.NewCalendarHandler(communityService)); err != nil {
+			log.Fatalf("Failed to serve calendar: %v", err)
+		}
+	}()
+
 	log.Println("gRPC server is running on port 50055")
 	if err := grpcServer.Serve(lis); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
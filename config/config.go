@@ -2,6 +2,7 @@ package config
 
 import (
 	"log"
+	"strings"
 
 	"github.com/spf13/viper"
 )
@@ -9,6 +10,8 @@ import (
 type Config struct {
 	Postgres PostgresConfig
 	Server   ServerConfig
+	Storage  StorageConfig
+	Auth     AuthConfig
 }
 
 type PostgresConfig struct {
@@ -20,14 +23,32 @@ type PostgresConfig struct {
 }
 
 type ServerConfig struct {
-	Host string
-	Port string
+	Host     string
+	Port     string
+	HTTPPort string // serves the iCalendar feed
+}
+
+type StorageConfig struct {
+	Driver string // "postgres" or "memory"
+}
+
+type AuthConfig struct {
+	JWTSecrets       []string // HMAC keys; more than one allows rotating them
+	JWTPublicKeyFile string   // PEM RSA, ECDSA or Ed25519 public key
+	JWTIssuer        string   // required iss claim, if set
+	InviteSecret     string   // HMAC key that signs community invite codes
 }
 
 func Load(path string) Config {
 	viper.SetConfigFile(".env")
 
 	viper.AddConfigPath(path)
+	viper.SetDefault("STORAGE_DRIVER", "postgres")
+	viper.SetDefault("HTTP_PORT", "8085")
+	viper.SetDefault("JWT_SECRETS", "")
+	viper.SetDefault("JWT_PUBLIC_KEY_FILE", "")
+	viper.SetDefault("JWT_ISSUER", "")
+	viper.SetDefault("INVITE_SECRET", "")
 
 	err := viper.ReadInConfig()
 	if err != nil {
@@ -43,8 +64,28 @@ func Load(path string) Config {
 			DbPassword: viper.Get("DB_PASSWORD").(string),
 		},
 		Server: ServerConfig{
-			Host: viper.Get("SERVER_HOST").(string),
-			Port: viper.Get("SERVER_PORT").(string),
+			Host:     viper.Get("SERVER_HOST").(string),
+			Port:     viper.Get("SERVER_PORT").(string),
+			HTTPPort: viper.GetString("HTTP_PORT"),
+		},
+		Storage: StorageConfig{
+			Driver: viper.GetString("STORAGE_DRIVER"),
 		},
+		Auth: AuthConfig{
+			JWTSecrets:       splitList(viper.GetString("JWT_SECRETS")),
+			JWTPublicKeyFile: viper.GetString("JWT_PUBLIC_KEY_FILE"),
+			JWTIssuer:        viper.GetString("JWT_ISSUER"),
+			InviteSecret:     viper.GetString("INVITE_SECRET"),
+		},
+	}
+}
+
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
 	}
+	return items
 }
@@ -19,21 +19,60 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	CommunityService_CreateCommunity_FullMethodName      = "/CommunityServer.CommunityService/CreateCommunity"
-	CommunityService_GetCommunityBy_FullMethodName       = "/CommunityServer.CommunityService/GetCommunityBy"
-	CommunityService_UpdateCommunity_FullMethodName      = "/CommunityServer.CommunityService/UpdateCommunity"
-	CommunityService_DeleteCommunity_FullMethodName      = "/CommunityServer.CommunityService/DeleteCommunity"
-	CommunityService_GetAllCommunity_FullMethodName      = "/CommunityServer.CommunityService/GetAllCommunity"
-	CommunityService_JoinCommunity_FullMethodName        = "/CommunityServer.CommunityService/JoinCommunity"
-	CommunityService_LeaveCommunity_FullMethodName       = "/CommunityServer.CommunityService/LeaveCommunity"
-	CommunityService_CreateCommunityEvent_FullMethodName = "/CommunityServer.CommunityService/CreateCommunityEvent"
-	CommunityService_GetCommunityEvent_FullMethodName    = "/CommunityServer.CommunityService/GetCommunityEvent"
-	CommunityService_IsUserValid_FullMethodName          = "/CommunityServer.CommunityService/IsUserValid"
+	CommunityService_CreateCommunity_FullMethodName             = "/CommunityServer.CommunityService/CreateCommunity"
+	CommunityService_GetCommunityBy_FullMethodName              = "/CommunityServer.CommunityService/GetCommunityBy"
+	CommunityService_UpdateCommunity_FullMethodName             = "/CommunityServer.CommunityService/UpdateCommunity"
+	CommunityService_DeleteCommunity_FullMethodName             = "/CommunityServer.CommunityService/DeleteCommunity"
+	CommunityService_GetAllCommunity_FullMethodName             = "/CommunityServer.CommunityService/GetAllCommunity"
+	CommunityService_JoinCommunity_FullMethodName               = "/CommunityServer.CommunityService/JoinCommunity"
+	CommunityService_LeaveCommunity_FullMethodName              = "/CommunityServer.CommunityService/LeaveCommunity"
+	CommunityService_CreateCommunityEvent_FullMethodName        = "/CommunityServer.CommunityService/CreateCommunityEvent"
+	CommunityService_GetCommunityEvent_FullMethodName           = "/CommunityServer.CommunityService/GetCommunityEvent"
+	CommunityService_IsUserValid_FullMethodName                 = "/CommunityServer.CommunityService/IsUserValid"
+	CommunityService_PromoteModerator_FullMethodName            = "/CommunityServer.CommunityService/PromoteModerator"
+	CommunityService_DemoteModerator_FullMethodName             = "/CommunityServer.CommunityService/DemoteModerator"
+	CommunityService_ListCommunityMembers_FullMethodName        = "/CommunityServer.CommunityService/ListCommunityMembers"
+	CommunityService_ListUserCommunities_FullMethodName         = "/CommunityServer.CommunityService/ListUserCommunities"
+	CommunityService_SearchCommunities_FullMethodName           = "/CommunityServer.CommunityService/SearchCommunities"
+	CommunityService_FindCommunitiesNearby_FullMethodName       = "/CommunityServer.CommunityService/FindCommunitiesNearby"
+	CommunityService_RsvpEvent_FullMethodName                   = "/CommunityServer.CommunityService/RsvpEvent"
+	CommunityService_CancelRsvp_FullMethodName                  = "/CommunityServer.CommunityService/CancelRsvp"
+	CommunityService_ListEventAttendees_FullMethodName          = "/CommunityServer.CommunityService/ListEventAttendees"
+	CommunityService_SetEventOccurrenceException_FullMethodName = "/CommunityServer.CommunityService/SetEventOccurrenceException"
+	CommunityService_ExportCommunityCalendar_FullMethodName     = "/CommunityServer.CommunityService/ExportCommunityCalendar"
+	CommunityService_ListCommunityEvents_FullMethodName         = "/CommunityServer.CommunityService/ListCommunityEvents"
+	CommunityService_ListUpcomingEventsForUser_FullMethodName   = "/CommunityServer.CommunityService/ListUpcomingEventsForUser"
+	CommunityService_UpdateCommunityEvent_FullMethodName        = "/CommunityServer.CommunityService/UpdateCommunityEvent"
+	CommunityService_DeleteCommunityEvent_FullMethodName        = "/CommunityServer.CommunityService/DeleteCommunityEvent"
+	CommunityService_ListEventChanges_FullMethodName            = "/CommunityServer.CommunityService/ListEventChanges"
+	CommunityService_CreateSeedListing_FullMethodName           = "/CommunityServer.CommunityService/CreateSeedListing"
+	CommunityService_ListSeedListings_FullMethodName            = "/CommunityServer.CommunityService/ListSeedListings"
+	CommunityService_CloseSeedListing_FullMethodName            = "/CommunityServer.CommunityService/CloseSeedListing"
+	CommunityService_ClaimSeedListing_FullMethodName            = "/CommunityServer.CommunityService/ClaimSeedListing"
+	CommunityService_UpdateSeedMatchStatus_FullMethodName       = "/CommunityServer.CommunityService/UpdateSeedMatchStatus"
+	CommunityService_ListSeedMatches_FullMethodName             = "/CommunityServer.CommunityService/ListSeedMatches"
+	CommunityService_RecordEventPlantings_FullMethodName        = "/CommunityServer.CommunityService/RecordEventPlantings"
+	CommunityService_ListEventPlantings_FullMethodName          = "/CommunityServer.CommunityService/ListEventPlantings"
+	CommunityService_GetCommunityGardenSummary_FullMethodName   = "/CommunityServer.CommunityService/GetCommunityGardenSummary"
+	CommunityService_ListJoinRequests_FullMethodName            = "/CommunityServer.CommunityService/ListJoinRequests"
+	CommunityService_ApproveJoinRequest_FullMethodName          = "/CommunityServer.CommunityService/ApproveJoinRequest"
+	CommunityService_RejectJoinRequest_FullMethodName           = "/CommunityServer.CommunityService/RejectJoinRequest"
+	CommunityService_CreateCommunityInvite_FullMethodName       = "/CommunityServer.CommunityService/CreateCommunityInvite"
+	CommunityService_RemoveMember_FullMethodName                = "/CommunityServer.CommunityService/RemoveMember"
+	CommunityService_BanMember_FullMethodName                   = "/CommunityServer.CommunityService/BanMember"
+	CommunityService_UnbanMember_FullMethodName                 = "/CommunityServer.CommunityService/UnbanMember"
+	CommunityService_ListModerationActions_FullMethodName       = "/CommunityServer.CommunityService/ListModerationActions"
+	CommunityService_TransferOwnership_FullMethodName           = "/CommunityServer.CommunityService/TransferOwnership"
+	CommunityService_RestoreCommunity_FullMethodName            = "/CommunityServer.CommunityService/RestoreCommunity"
 )
 
 // CommunityServiceClient is the client API for CommunityService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Calls carry an "authorization: Bearer <JWT>" metadata entry, except for the read-only
+// methods listed in services.PublicMethods. A request's user_id defaults to the caller and
+// may only name another user when the caller's token has the admin role.
 type CommunityServiceClient interface {
 	CreateCommunity(ctx context.Context, in *CreateCommunityRequest, opts ...grpc.CallOption) (*CreateCommunityResponse, error)
 	GetCommunityBy(ctx context.Context, in *GetCommunityRequest, opts ...grpc.CallOption) (*GetCommunityResponse, error)
@@ -45,6 +84,41 @@ type CommunityServiceClient interface {
 	CreateCommunityEvent(ctx context.Context, in *CreateCommunityEventRequest, opts ...grpc.CallOption) (*CreateCommunityEventResponse, error)
 	GetCommunityEvent(ctx context.Context, in *GetCommunityEventRequest, opts ...grpc.CallOption) (*GetCommunityEventResponse, error)
 	IsUserValid(ctx context.Context, in *IsCommunityValidRequest, opts ...grpc.CallOption) (*IsCommunityValidResponse, error)
+	PromoteModerator(ctx context.Context, in *MemberRoleRequest, opts ...grpc.CallOption) (*MemberRoleResponse, error)
+	DemoteModerator(ctx context.Context, in *MemberRoleRequest, opts ...grpc.CallOption) (*MemberRoleResponse, error)
+	ListCommunityMembers(ctx context.Context, in *ListCommunityMembersRequest, opts ...grpc.CallOption) (*ListCommunityMembersResponse, error)
+	ListUserCommunities(ctx context.Context, in *ListUserCommunitiesRequest, opts ...grpc.CallOption) (*ListUserCommunitiesResponse, error)
+	SearchCommunities(ctx context.Context, in *SearchCommunitiesRequest, opts ...grpc.CallOption) (*SearchCommunitiesResponse, error)
+	FindCommunitiesNearby(ctx context.Context, in *FindCommunitiesNearbyRequest, opts ...grpc.CallOption) (*FindCommunitiesNearbyResponse, error)
+	RsvpEvent(ctx context.Context, in *RsvpEventRequest, opts ...grpc.CallOption) (*RsvpEventResponse, error)
+	CancelRsvp(ctx context.Context, in *CancelRsvpRequest, opts ...grpc.CallOption) (*CancelRsvpResponse, error)
+	ListEventAttendees(ctx context.Context, in *ListEventAttendeesRequest, opts ...grpc.CallOption) (*ListEventAttendeesResponse, error)
+	SetEventOccurrenceException(ctx context.Context, in *SetEventOccurrenceExceptionRequest, opts ...grpc.CallOption) (*SetEventOccurrenceExceptionResponse, error)
+	ExportCommunityCalendar(ctx context.Context, in *ExportCommunityCalendarRequest, opts ...grpc.CallOption) (*ExportCommunityCalendarResponse, error)
+	ListCommunityEvents(ctx context.Context, in *ListCommunityEventsRequest, opts ...grpc.CallOption) (*ListCommunityEventsResponse, error)
+	ListUpcomingEventsForUser(ctx context.Context, in *ListUpcomingEventsForUserRequest, opts ...grpc.CallOption) (*ListCommunityEventsResponse, error)
+	UpdateCommunityEvent(ctx context.Context, in *UpdateCommunityEventRequest, opts ...grpc.CallOption) (*UpdateCommunityEventResponse, error)
+	DeleteCommunityEvent(ctx context.Context, in *DeleteCommunityEventRequest, opts ...grpc.CallOption) (*DeleteCommunityEventResponse, error)
+	ListEventChanges(ctx context.Context, in *ListEventChangesRequest, opts ...grpc.CallOption) (*ListEventChangesResponse, error)
+	CreateSeedListing(ctx context.Context, in *CreateSeedListingRequest, opts ...grpc.CallOption) (*SeedListingResponse, error)
+	ListSeedListings(ctx context.Context, in *ListSeedListingsRequest, opts ...grpc.CallOption) (*ListSeedListingsResponse, error)
+	CloseSeedListing(ctx context.Context, in *CloseSeedListingRequest, opts ...grpc.CallOption) (*SeedListingResponse, error)
+	ClaimSeedListing(ctx context.Context, in *ClaimSeedListingRequest, opts ...grpc.CallOption) (*SeedMatchResponse, error)
+	UpdateSeedMatchStatus(ctx context.Context, in *UpdateSeedMatchStatusRequest, opts ...grpc.CallOption) (*SeedMatchResponse, error)
+	ListSeedMatches(ctx context.Context, in *ListSeedMatchesRequest, opts ...grpc.CallOption) (*ListSeedMatchesResponse, error)
+	RecordEventPlantings(ctx context.Context, in *RecordEventPlantingsRequest, opts ...grpc.CallOption) (*RecordEventPlantingsResponse, error)
+	ListEventPlantings(ctx context.Context, in *ListEventPlantingsRequest, opts ...grpc.CallOption) (*ListEventPlantingsResponse, error)
+	GetCommunityGardenSummary(ctx context.Context, in *GetCommunityGardenSummaryRequest, opts ...grpc.CallOption) (*GetCommunityGardenSummaryResponse, error)
+	ListJoinRequests(ctx context.Context, in *ListJoinRequestsRequest, opts ...grpc.CallOption) (*ListJoinRequestsResponse, error)
+	ApproveJoinRequest(ctx context.Context, in *JoinRequestDecisionRequest, opts ...grpc.CallOption) (*JoinRequestDecisionResponse, error)
+	RejectJoinRequest(ctx context.Context, in *JoinRequestDecisionRequest, opts ...grpc.CallOption) (*JoinRequestDecisionResponse, error)
+	CreateCommunityInvite(ctx context.Context, in *CreateCommunityInviteRequest, opts ...grpc.CallOption) (*CreateCommunityInviteResponse, error)
+	RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*ModerationActionResponse, error)
+	BanMember(ctx context.Context, in *BanMemberRequest, opts ...grpc.CallOption) (*ModerationActionResponse, error)
+	UnbanMember(ctx context.Context, in *UnbanMemberRequest, opts ...grpc.CallOption) (*ModerationActionResponse, error)
+	ListModerationActions(ctx context.Context, in *ListModerationActionsRequest, opts ...grpc.CallOption) (*ListModerationActionsResponse, error)
+	TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*TransferOwnershipResponse, error)
+	RestoreCommunity(ctx context.Context, in *RestoreCommunityRequest, opts ...grpc.CallOption) (*RestoreCommunityResponse, error)
 }
 
 type communityServiceClient struct {
@@ -112,289 +186,1413 @@ func (c *communityServiceClient) JoinCommunity(ctx context.Context, in *JoinComm
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *communityServiceClient) LeaveCommunity(ctx context.Context, in *LeaveCommunityRequest, opts ...grpc.CallOption) (*LeaveCommunityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveCommunityResponse)
+	err := c.cc.Invoke(ctx, CommunityService_LeaveCommunity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) CreateCommunityEvent(ctx context.Context, in *CreateCommunityEventRequest, opts ...grpc.CallOption) (*CreateCommunityEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCommunityEventResponse)
+	err := c.cc.Invoke(ctx, CommunityService_CreateCommunityEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) GetCommunityEvent(ctx context.Context, in *GetCommunityEventRequest, opts ...grpc.CallOption) (*GetCommunityEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommunityEventResponse)
+	err := c.cc.Invoke(ctx, CommunityService_GetCommunityEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) IsUserValid(ctx context.Context, in *IsCommunityValidRequest, opts ...grpc.CallOption) (*IsCommunityValidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsCommunityValidResponse)
+	err := c.cc.Invoke(ctx, CommunityService_IsUserValid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) PromoteModerator(ctx context.Context, in *MemberRoleRequest, opts ...grpc.CallOption) (*MemberRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemberRoleResponse)
+	err := c.cc.Invoke(ctx, CommunityService_PromoteModerator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) DemoteModerator(ctx context.Context, in *MemberRoleRequest, opts ...grpc.CallOption) (*MemberRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemberRoleResponse)
+	err := c.cc.Invoke(ctx, CommunityService_DemoteModerator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) ListCommunityMembers(ctx context.Context, in *ListCommunityMembersRequest, opts ...grpc.CallOption) (*ListCommunityMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommunityMembersResponse)
+	err := c.cc.Invoke(ctx, CommunityService_ListCommunityMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) ListUserCommunities(ctx context.Context, in *ListUserCommunitiesRequest, opts ...grpc.CallOption) (*ListUserCommunitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserCommunitiesResponse)
+	err := c.cc.Invoke(ctx, CommunityService_ListUserCommunities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) SearchCommunities(ctx context.Context, in *SearchCommunitiesRequest, opts ...grpc.CallOption) (*SearchCommunitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchCommunitiesResponse)
+	err := c.cc.Invoke(ctx, CommunityService_SearchCommunities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) FindCommunitiesNearby(ctx context.Context, in *FindCommunitiesNearbyRequest, opts ...grpc.CallOption) (*FindCommunitiesNearbyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindCommunitiesNearbyResponse)
+	err := c.cc.Invoke(ctx, CommunityService_FindCommunitiesNearby_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) RsvpEvent(ctx context.Context, in *RsvpEventRequest, opts ...grpc.CallOption) (*RsvpEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RsvpEventResponse)
+	err := c.cc.Invoke(ctx, CommunityService_RsvpEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) CancelRsvp(ctx context.Context, in *CancelRsvpRequest, opts ...grpc.CallOption) (*CancelRsvpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelRsvpResponse)
+	err := c.cc.Invoke(ctx, CommunityService_CancelRsvp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) ListEventAttendees(ctx context.Context, in *ListEventAttendeesRequest, opts ...grpc.CallOption) (*ListEventAttendeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventAttendeesResponse)
+	err := c.cc.Invoke(ctx, CommunityService_ListEventAttendees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) SetEventOccurrenceException(ctx context.Context, in *SetEventOccurrenceExceptionRequest, opts ...grpc.CallOption) (*SetEventOccurrenceExceptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetEventOccurrenceExceptionResponse)
+	err := c.cc.Invoke(ctx, CommunityService_SetEventOccurrenceException_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) ExportCommunityCalendar(ctx context.Context, in *ExportCommunityCalendarRequest, opts ...grpc.CallOption) (*ExportCommunityCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportCommunityCalendarResponse)
+	err := c.cc.Invoke(ctx, CommunityService_ExportCommunityCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) ListCommunityEvents(ctx context.Context, in *ListCommunityEventsRequest, opts ...grpc.CallOption) (*ListCommunityEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommunityEventsResponse)
+	err := c.cc.Invoke(ctx, CommunityService_ListCommunityEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) ListUpcomingEventsForUser(ctx context.Context, in *ListUpcomingEventsForUserRequest, opts ...grpc.CallOption) (*ListCommunityEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommunityEventsResponse)
+	err := c.cc.Invoke(ctx, CommunityService_ListUpcomingEventsForUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) UpdateCommunityEvent(ctx context.Context, in *UpdateCommunityEventRequest, opts ...grpc.CallOption) (*UpdateCommunityEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCommunityEventResponse)
+	err := c.cc.Invoke(ctx, CommunityService_UpdateCommunityEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) DeleteCommunityEvent(ctx context.Context, in *DeleteCommunityEventRequest, opts ...grpc.CallOption) (*DeleteCommunityEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCommunityEventResponse)
+	err := c.cc.Invoke(ctx, CommunityService_DeleteCommunityEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) ListEventChanges(ctx context.Context, in *ListEventChangesRequest, opts ...grpc.CallOption) (*ListEventChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventChangesResponse)
+	err := c.cc.Invoke(ctx, CommunityService_ListEventChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) CreateSeedListing(ctx context.Context, in *CreateSeedListingRequest, opts ...grpc.CallOption) (*SeedListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeedListingResponse)
+	err := c.cc.Invoke(ctx, CommunityService_CreateSeedListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) ListSeedListings(ctx context.Context, in *ListSeedListingsRequest, opts ...grpc.CallOption) (*ListSeedListingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSeedListingsResponse)
+	err := c.cc.Invoke(ctx, CommunityService_ListSeedListings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) CloseSeedListing(ctx context.Context, in *CloseSeedListingRequest, opts ...grpc.CallOption) (*SeedListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeedListingResponse)
+	err := c.cc.Invoke(ctx, CommunityService_CloseSeedListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) ClaimSeedListing(ctx context.Context, in *ClaimSeedListingRequest, opts ...grpc.CallOption) (*SeedMatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeedMatchResponse)
+	err := c.cc.Invoke(ctx, CommunityService_ClaimSeedListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) UpdateSeedMatchStatus(ctx context.Context, in *UpdateSeedMatchStatusRequest, opts ...grpc.CallOption) (*SeedMatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeedMatchResponse)
+	err := c.cc.Invoke(ctx, CommunityService_UpdateSeedMatchStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) ListSeedMatches(ctx context.Context, in *ListSeedMatchesRequest, opts ...grpc.CallOption) (*ListSeedMatchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSeedMatchesResponse)
+	err := c.cc.Invoke(ctx, CommunityService_ListSeedMatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) RecordEventPlantings(ctx context.Context, in *RecordEventPlantingsRequest, opts ...grpc.CallOption) (*RecordEventPlantingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordEventPlantingsResponse)
+	err := c.cc.Invoke(ctx, CommunityService_RecordEventPlantings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) ListEventPlantings(ctx context.Context, in *ListEventPlantingsRequest, opts ...grpc.CallOption) (*ListEventPlantingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventPlantingsResponse)
+	err := c.cc.Invoke(ctx, CommunityService_ListEventPlantings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) GetCommunityGardenSummary(ctx context.Context, in *GetCommunityGardenSummaryRequest, opts ...grpc.CallOption) (*GetCommunityGardenSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommunityGardenSummaryResponse)
+	err := c.cc.Invoke(ctx, CommunityService_GetCommunityGardenSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) ListJoinRequests(ctx context.Context, in *ListJoinRequestsRequest, opts ...grpc.CallOption) (*ListJoinRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJoinRequestsResponse)
+	err := c.cc.Invoke(ctx, CommunityService_ListJoinRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) ApproveJoinRequest(ctx context.Context, in *JoinRequestDecisionRequest, opts ...grpc.CallOption) (*JoinRequestDecisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinRequestDecisionResponse)
+	err := c.cc.Invoke(ctx, CommunityService_ApproveJoinRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) RejectJoinRequest(ctx context.Context, in *JoinRequestDecisionRequest, opts ...grpc.CallOption) (*JoinRequestDecisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinRequestDecisionResponse)
+	err := c.cc.Invoke(ctx, CommunityService_RejectJoinRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) CreateCommunityInvite(ctx context.Context, in *CreateCommunityInviteRequest, opts ...grpc.CallOption) (*CreateCommunityInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCommunityInviteResponse)
+	err := c.cc.Invoke(ctx, CommunityService_CreateCommunityInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*ModerationActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerationActionResponse)
+	err := c.cc.Invoke(ctx, CommunityService_RemoveMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) BanMember(ctx context.Context, in *BanMemberRequest, opts ...grpc.CallOption) (*ModerationActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerationActionResponse)
+	err := c.cc.Invoke(ctx, CommunityService_BanMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) UnbanMember(ctx context.Context, in *UnbanMemberRequest, opts ...grpc.CallOption) (*ModerationActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerationActionResponse)
+	err := c.cc.Invoke(ctx, CommunityService_UnbanMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) ListModerationActions(ctx context.Context, in *ListModerationActionsRequest, opts ...grpc.CallOption) (*ListModerationActionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListModerationActionsResponse)
+	err := c.cc.Invoke(ctx, CommunityService_ListModerationActions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*TransferOwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferOwnershipResponse)
+	err := c.cc.Invoke(ctx, CommunityService_TransferOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityServiceClient) RestoreCommunity(ctx context.Context, in *RestoreCommunityRequest, opts ...grpc.CallOption) (*RestoreCommunityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreCommunityResponse)
+	err := c.cc.Invoke(ctx, CommunityService_RestoreCommunity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CommunityServiceServer is the server API for CommunityService service.
+// All implementations must embed UnimplementedCommunityServiceServer
+// for forward compatibility
+//
+// Calls carry an "authorization: Bearer <JWT>" metadata entry, except for the read-only
+// methods listed in services.PublicMethods. A request's user_id defaults to the caller and
+// may only name another user when the caller's token has the admin role.
+type CommunityServiceServer interface {
+	CreateCommunity(context.Context, *CreateCommunityRequest) (*CreateCommunityResponse, error)
+	GetCommunityBy(context.Context, *GetCommunityRequest) (*GetCommunityResponse, error)
+	UpdateCommunity(context.Context, *UpdateCommunityRequest) (*UpdateCommunityResponse, error)
+	DeleteCommunity(context.Context, *DeleteCommunityRequest) (*DeleteCommunityResponse, error)
+	GetAllCommunity(context.Context, *GetAllCommunityRequest) (*GetAllCommunityResponse, error)
+	JoinCommunity(context.Context, *JoinCommunityRequest) (*JoinCommunityResponse, error)
+	LeaveCommunity(context.Context, *LeaveCommunityRequest) (*LeaveCommunityResponse, error)
+	CreateCommunityEvent(context.Context, *CreateCommunityEventRequest) (*CreateCommunityEventResponse, error)
+	GetCommunityEvent(context.Context, *GetCommunityEventRequest) (*GetCommunityEventResponse, error)
+	IsUserValid(context.Context, *IsCommunityValidRequest) (*IsCommunityValidResponse, error)
+	PromoteModerator(context.Context, *MemberRoleRequest) (*MemberRoleResponse, error)
+	DemoteModerator(context.Context, *MemberRoleRequest) (*MemberRoleResponse, error)
+	ListCommunityMembers(context.Context, *ListCommunityMembersRequest) (*ListCommunityMembersResponse, error)
+	ListUserCommunities(context.Context, *ListUserCommunitiesRequest) (*ListUserCommunitiesResponse, error)
+	SearchCommunities(context.Context, *SearchCommunitiesRequest) (*SearchCommunitiesResponse, error)
+	FindCommunitiesNearby(context.Context, *FindCommunitiesNearbyRequest) (*FindCommunitiesNearbyResponse, error)
+	RsvpEvent(context.Context, *RsvpEventRequest) (*RsvpEventResponse, error)
+	CancelRsvp(context.Context, *CancelRsvpRequest) (*CancelRsvpResponse, error)
+	ListEventAttendees(context.Context, *ListEventAttendeesRequest) (*ListEventAttendeesResponse, error)
+	SetEventOccurrenceException(context.Context, *SetEventOccurrenceExceptionRequest) (*SetEventOccurrenceExceptionResponse, error)
+	ExportCommunityCalendar(context.Context, *ExportCommunityCalendarRequest) (*ExportCommunityCalendarResponse, error)
+	ListCommunityEvents(context.Context, *ListCommunityEventsRequest) (*ListCommunityEventsResponse, error)
+	ListUpcomingEventsForUser(context.Context, *ListUpcomingEventsForUserRequest) (*ListCommunityEventsResponse, error)
+	UpdateCommunityEvent(context.Context, *UpdateCommunityEventRequest) (*UpdateCommunityEventResponse, error)
+	DeleteCommunityEvent(context.Context, *DeleteCommunityEventRequest) (*DeleteCommunityEventResponse, error)
+	ListEventChanges(context.Context, *ListEventChangesRequest) (*ListEventChangesResponse, error)
+	CreateSeedListing(context.Context, *CreateSeedListingRequest) (*SeedListingResponse, error)
+	ListSeedListings(context.Context, *ListSeedListingsRequest) (*ListSeedListingsResponse, error)
+	CloseSeedListing(context.Context, *CloseSeedListingRequest) (*SeedListingResponse, error)
+	ClaimSeedListing(context.Context, *ClaimSeedListingRequest) (*SeedMatchResponse, error)
+	UpdateSeedMatchStatus(context.Context, *UpdateSeedMatchStatusRequest) (*SeedMatchResponse, error)
+	ListSeedMatches(context.Context, *ListSeedMatchesRequest) (*ListSeedMatchesResponse, error)
+	RecordEventPlantings(context.Context, *RecordEventPlantingsRequest) (*RecordEventPlantingsResponse, error)
+	ListEventPlantings(context.Context, *ListEventPlantingsRequest) (*ListEventPlantingsResponse, error)
+	GetCommunityGardenSummary(context.Context, *GetCommunityGardenSummaryRequest) (*GetCommunityGardenSummaryResponse, error)
+	ListJoinRequests(context.Context, *ListJoinRequestsRequest) (*ListJoinRequestsResponse, error)
+	ApproveJoinRequest(context.Context, *JoinRequestDecisionRequest) (*JoinRequestDecisionResponse, error)
+	RejectJoinRequest(context.Context, *JoinRequestDecisionRequest) (*JoinRequestDecisionResponse, error)
+	CreateCommunityInvite(context.Context, *CreateCommunityInviteRequest) (*CreateCommunityInviteResponse, error)
+	RemoveMember(context.Context, *RemoveMemberRequest) (*ModerationActionResponse, error)
+	BanMember(context.Context, *BanMemberRequest) (*ModerationActionResponse, error)
+	UnbanMember(context.Context, *UnbanMemberRequest) (*ModerationActionResponse, error)
+	ListModerationActions(context.Context, *ListModerationActionsRequest) (*ListModerationActionsResponse, error)
+	TransferOwnership(context.Context, *TransferOwnershipRequest) (*TransferOwnershipResponse, error)
+	RestoreCommunity(context.Context, *RestoreCommunityRequest) (*RestoreCommunityResponse, error)
+	mustEmbedUnimplementedCommunityServiceServer()
+}
+
+// UnimplementedCommunityServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCommunityServiceServer struct {
+}
+
+func (UnimplementedCommunityServiceServer) CreateCommunity(context.Context, *CreateCommunityRequest) (*CreateCommunityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCommunity not implemented")
+}
+func (UnimplementedCommunityServiceServer) GetCommunityBy(context.Context, *GetCommunityRequest) (*GetCommunityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommunityBy not implemented")
+}
+func (UnimplementedCommunityServiceServer) UpdateCommunity(context.Context, *UpdateCommunityRequest) (*UpdateCommunityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCommunity not implemented")
+}
+func (UnimplementedCommunityServiceServer) DeleteCommunity(context.Context, *DeleteCommunityRequest) (*DeleteCommunityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCommunity not implemented")
+}
+func (UnimplementedCommunityServiceServer) GetAllCommunity(context.Context, *GetAllCommunityRequest) (*GetAllCommunityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCommunity not implemented")
+}
+func (UnimplementedCommunityServiceServer) JoinCommunity(context.Context, *JoinCommunityRequest) (*JoinCommunityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinCommunity not implemented")
+}
+func (UnimplementedCommunityServiceServer) LeaveCommunity(context.Context, *LeaveCommunityRequest) (*LeaveCommunityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveCommunity not implemented")
+}
+func (UnimplementedCommunityServiceServer) CreateCommunityEvent(context.Context, *CreateCommunityEventRequest) (*CreateCommunityEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCommunityEvent not implemented")
+}
+func (UnimplementedCommunityServiceServer) GetCommunityEvent(context.Context, *GetCommunityEventRequest) (*GetCommunityEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommunityEvent not implemented")
+}
+func (UnimplementedCommunityServiceServer) IsUserValid(context.Context, *IsCommunityValidRequest) (*IsCommunityValidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsUserValid not implemented")
+}
+func (UnimplementedCommunityServiceServer) PromoteModerator(context.Context, *MemberRoleRequest) (*MemberRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteModerator not implemented")
+}
+func (UnimplementedCommunityServiceServer) DemoteModerator(context.Context, *MemberRoleRequest) (*MemberRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DemoteModerator not implemented")
+}
+func (UnimplementedCommunityServiceServer) ListCommunityMembers(context.Context, *ListCommunityMembersRequest) (*ListCommunityMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommunityMembers not implemented")
+}
+func (UnimplementedCommunityServiceServer) ListUserCommunities(context.Context, *ListUserCommunitiesRequest) (*ListUserCommunitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserCommunities not implemented")
+}
+func (UnimplementedCommunityServiceServer) SearchCommunities(context.Context, *SearchCommunitiesRequest) (*SearchCommunitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCommunities not implemented")
+}
+func (UnimplementedCommunityServiceServer) FindCommunitiesNearby(context.Context, *FindCommunitiesNearbyRequest) (*FindCommunitiesNearbyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindCommunitiesNearby not implemented")
+}
+func (UnimplementedCommunityServiceServer) RsvpEvent(context.Context, *RsvpEventRequest) (*RsvpEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RsvpEvent not implemented")
+}
+func (UnimplementedCommunityServiceServer) CancelRsvp(context.Context, *CancelRsvpRequest) (*CancelRsvpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRsvp not implemented")
+}
+func (UnimplementedCommunityServiceServer) ListEventAttendees(context.Context, *ListEventAttendeesRequest) (*ListEventAttendeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEventAttendees not implemented")
+}
+func (UnimplementedCommunityServiceServer) SetEventOccurrenceException(context.Context, *SetEventOccurrenceExceptionRequest) (*SetEventOccurrenceExceptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEventOccurrenceException not implemented")
+}
+func (UnimplementedCommunityServiceServer) ExportCommunityCalendar(context.Context, *ExportCommunityCalendarRequest) (*ExportCommunityCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportCommunityCalendar not implemented")
+}
+func (UnimplementedCommunityServiceServer) ListCommunityEvents(context.Context, *ListCommunityEventsRequest) (*ListCommunityEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommunityEvents not implemented")
+}
+func (UnimplementedCommunityServiceServer) ListUpcomingEventsForUser(context.Context, *ListUpcomingEventsForUserRequest) (*ListCommunityEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUpcomingEventsForUser not implemented")
+}
+func (UnimplementedCommunityServiceServer) UpdateCommunityEvent(context.Context, *UpdateCommunityEventRequest) (*UpdateCommunityEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCommunityEvent not implemented")
+}
+func (UnimplementedCommunityServiceServer) DeleteCommunityEvent(context.Context, *DeleteCommunityEventRequest) (*DeleteCommunityEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCommunityEvent not implemented")
+}
+func (UnimplementedCommunityServiceServer) ListEventChanges(context.Context, *ListEventChangesRequest) (*ListEventChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEventChanges not implemented")
+}
+func (UnimplementedCommunityServiceServer) CreateSeedListing(context.Context, *CreateSeedListingRequest) (*SeedListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSeedListing not implemented")
+}
+func (UnimplementedCommunityServiceServer) ListSeedListings(context.Context, *ListSeedListingsRequest) (*ListSeedListingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSeedListings not implemented")
+}
+func (UnimplementedCommunityServiceServer) CloseSeedListing(context.Context, *CloseSeedListingRequest) (*SeedListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseSeedListing not implemented")
+}
+func (UnimplementedCommunityServiceServer) ClaimSeedListing(context.Context, *ClaimSeedListingRequest) (*SeedMatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimSeedListing not implemented")
+}
+func (UnimplementedCommunityServiceServer) UpdateSeedMatchStatus(context.Context, *UpdateSeedMatchStatusRequest) (*SeedMatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSeedMatchStatus not implemented")
+}
+func (UnimplementedCommunityServiceServer) ListSeedMatches(context.Context, *ListSeedMatchesRequest) (*ListSeedMatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSeedMatches not implemented")
+}
+func (UnimplementedCommunityServiceServer) RecordEventPlantings(context.Context, *RecordEventPlantingsRequest) (*RecordEventPlantingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordEventPlantings not implemented")
+}
+func (UnimplementedCommunityServiceServer) ListEventPlantings(context.Context, *ListEventPlantingsRequest) (*ListEventPlantingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEventPlantings not implemented")
+}
+func (UnimplementedCommunityServiceServer) GetCommunityGardenSummary(context.Context, *GetCommunityGardenSummaryRequest) (*GetCommunityGardenSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommunityGardenSummary not implemented")
+}
+func (UnimplementedCommunityServiceServer) ListJoinRequests(context.Context, *ListJoinRequestsRequest) (*ListJoinRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJoinRequests not implemented")
+}
+func (UnimplementedCommunityServiceServer) ApproveJoinRequest(context.Context, *JoinRequestDecisionRequest) (*JoinRequestDecisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveJoinRequest not implemented")
+}
+func (UnimplementedCommunityServiceServer) RejectJoinRequest(context.Context, *JoinRequestDecisionRequest) (*JoinRequestDecisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectJoinRequest not implemented")
+}
+func (UnimplementedCommunityServiceServer) CreateCommunityInvite(context.Context, *CreateCommunityInviteRequest) (*CreateCommunityInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCommunityInvite not implemented")
+}
+func (UnimplementedCommunityServiceServer) RemoveMember(context.Context, *RemoveMemberRequest) (*ModerationActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMember not implemented")
+}
+func (UnimplementedCommunityServiceServer) BanMember(context.Context, *BanMemberRequest) (*ModerationActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanMember not implemented")
+}
+func (UnimplementedCommunityServiceServer) UnbanMember(context.Context, *UnbanMemberRequest) (*ModerationActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanMember not implemented")
+}
+func (UnimplementedCommunityServiceServer) ListModerationActions(context.Context, *ListModerationActionsRequest) (*ListModerationActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModerationActions not implemented")
+}
+func (UnimplementedCommunityServiceServer) TransferOwnership(context.Context, *TransferOwnershipRequest) (*TransferOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
+}
+func (UnimplementedCommunityServiceServer) RestoreCommunity(context.Context, *RestoreCommunityRequest) (*RestoreCommunityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCommunity not implemented")
+}
+func (UnimplementedCommunityServiceServer) mustEmbedUnimplementedCommunityServiceServer() {}
+
+// UnsafeCommunityServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CommunityServiceServer will
+// result in compilation errors.
+type UnsafeCommunityServiceServer interface {
+	mustEmbedUnimplementedCommunityServiceServer()
+}
+
+func RegisterCommunityServiceServer(s grpc.ServiceRegistrar, srv CommunityServiceServer) {
+	s.RegisterService(&CommunityService_ServiceDesc, srv)
+}
+
+func _CommunityService_CreateCommunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommunityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).CreateCommunity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_CreateCommunity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).CreateCommunity(ctx, req.(*CreateCommunityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_GetCommunityBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommunityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).GetCommunityBy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_GetCommunityBy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).GetCommunityBy(ctx, req.(*GetCommunityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_UpdateCommunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCommunityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).UpdateCommunity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_UpdateCommunity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).UpdateCommunity(ctx, req.(*UpdateCommunityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_DeleteCommunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommunityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).DeleteCommunity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_DeleteCommunity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).DeleteCommunity(ctx, req.(*DeleteCommunityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_GetAllCommunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllCommunityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).GetAllCommunity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_GetAllCommunity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).GetAllCommunity(ctx, req.(*GetAllCommunityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_JoinCommunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinCommunityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).JoinCommunity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_JoinCommunity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).JoinCommunity(ctx, req.(*JoinCommunityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_LeaveCommunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveCommunityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).LeaveCommunity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_LeaveCommunity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).LeaveCommunity(ctx, req.(*LeaveCommunityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_CreateCommunityEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommunityEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).CreateCommunityEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_CreateCommunityEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).CreateCommunityEvent(ctx, req.(*CreateCommunityEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_GetCommunityEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommunityEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).GetCommunityEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_GetCommunityEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).GetCommunityEvent(ctx, req.(*GetCommunityEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_IsUserValid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsCommunityValidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).IsUserValid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_IsUserValid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).IsUserValid(ctx, req.(*IsCommunityValidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_PromoteModerator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).PromoteModerator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_PromoteModerator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).PromoteModerator(ctx, req.(*MemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_DemoteModerator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).DemoteModerator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_DemoteModerator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).DemoteModerator(ctx, req.(*MemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_ListCommunityMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommunityMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).ListCommunityMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_ListCommunityMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).ListCommunityMembers(ctx, req.(*ListCommunityMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_ListUserCommunities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserCommunitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).ListUserCommunities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_ListUserCommunities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).ListUserCommunities(ctx, req.(*ListUserCommunitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_SearchCommunities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCommunitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).SearchCommunities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_SearchCommunities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).SearchCommunities(ctx, req.(*SearchCommunitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_FindCommunitiesNearby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindCommunitiesNearbyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).FindCommunitiesNearby(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_FindCommunitiesNearby_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).FindCommunitiesNearby(ctx, req.(*FindCommunitiesNearbyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_RsvpEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RsvpEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).RsvpEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_RsvpEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).RsvpEvent(ctx, req.(*RsvpEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_CancelRsvp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRsvpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).CancelRsvp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_CancelRsvp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).CancelRsvp(ctx, req.(*CancelRsvpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_ListEventAttendees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventAttendeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).ListEventAttendees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_ListEventAttendees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).ListEventAttendees(ctx, req.(*ListEventAttendeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_SetEventOccurrenceException_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEventOccurrenceExceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).SetEventOccurrenceException(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_SetEventOccurrenceException_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).SetEventOccurrenceException(ctx, req.(*SetEventOccurrenceExceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_ExportCommunityCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCommunityCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).ExportCommunityCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_ExportCommunityCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).ExportCommunityCalendar(ctx, req.(*ExportCommunityCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_ListCommunityEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommunityEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).ListCommunityEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_ListCommunityEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).ListCommunityEvents(ctx, req.(*ListCommunityEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_ListUpcomingEventsForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUpcomingEventsForUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).ListUpcomingEventsForUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_ListUpcomingEventsForUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).ListUpcomingEventsForUser(ctx, req.(*ListUpcomingEventsForUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_UpdateCommunityEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCommunityEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).UpdateCommunityEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_UpdateCommunityEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).UpdateCommunityEvent(ctx, req.(*UpdateCommunityEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_DeleteCommunityEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommunityEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).DeleteCommunityEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_DeleteCommunityEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).DeleteCommunityEvent(ctx, req.(*DeleteCommunityEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_ListEventChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).ListEventChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_ListEventChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).ListEventChanges(ctx, req.(*ListEventChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_CreateSeedListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSeedListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).CreateSeedListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_CreateSeedListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).CreateSeedListing(ctx, req.(*CreateSeedListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_ListSeedListings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSeedListingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).ListSeedListings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_ListSeedListings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).ListSeedListings(ctx, req.(*ListSeedListingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_CloseSeedListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseSeedListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).CloseSeedListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_CloseSeedListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).CloseSeedListing(ctx, req.(*CloseSeedListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommunityService_ClaimSeedListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimSeedListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).ClaimSeedListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_ClaimSeedListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).ClaimSeedListing(ctx, req.(*ClaimSeedListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *communityServiceClient) LeaveCommunity(ctx context.Context, in *LeaveCommunityRequest, opts ...grpc.CallOption) (*LeaveCommunityResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(LeaveCommunityResponse)
-	err := c.cc.Invoke(ctx, CommunityService_LeaveCommunity_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _CommunityService_UpdateSeedMatchStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSeedMatchStatusRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).UpdateSeedMatchStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_UpdateSeedMatchStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).UpdateSeedMatchStatus(ctx, req.(*UpdateSeedMatchStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *communityServiceClient) CreateCommunityEvent(ctx context.Context, in *CreateCommunityEventRequest, opts ...grpc.CallOption) (*CreateCommunityEventResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateCommunityEventResponse)
-	err := c.cc.Invoke(ctx, CommunityService_CreateCommunityEvent_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _CommunityService_ListSeedMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSeedMatchesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).ListSeedMatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_ListSeedMatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).ListSeedMatches(ctx, req.(*ListSeedMatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *communityServiceClient) GetCommunityEvent(ctx context.Context, in *GetCommunityEventRequest, opts ...grpc.CallOption) (*GetCommunityEventResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetCommunityEventResponse)
-	err := c.cc.Invoke(ctx, CommunityService_GetCommunityEvent_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _CommunityService_RecordEventPlantings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordEventPlantingsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).RecordEventPlantings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_RecordEventPlantings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).RecordEventPlantings(ctx, req.(*RecordEventPlantingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *communityServiceClient) IsUserValid(ctx context.Context, in *IsCommunityValidRequest, opts ...grpc.CallOption) (*IsCommunityValidResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(IsCommunityValidResponse)
-	err := c.cc.Invoke(ctx, CommunityService_IsUserValid_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _CommunityService_ListEventPlantings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventPlantingsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// CommunityServiceServer is the server API for CommunityService service.
-// All implementations must embed UnimplementedCommunityServiceServer
-// for forward compatibility
-type CommunityServiceServer interface {
-	CreateCommunity(context.Context, *CreateCommunityRequest) (*CreateCommunityResponse, error)
-	GetCommunityBy(context.Context, *GetCommunityRequest) (*GetCommunityResponse, error)
-	UpdateCommunity(context.Context, *UpdateCommunityRequest) (*UpdateCommunityResponse, error)
-	DeleteCommunity(context.Context, *DeleteCommunityRequest) (*DeleteCommunityResponse, error)
-	GetAllCommunity(context.Context, *GetAllCommunityRequest) (*GetAllCommunityResponse, error)
-	JoinCommunity(context.Context, *JoinCommunityRequest) (*JoinCommunityResponse, error)
-	LeaveCommunity(context.Context, *LeaveCommunityRequest) (*LeaveCommunityResponse, error)
-	CreateCommunityEvent(context.Context, *CreateCommunityEventRequest) (*CreateCommunityEventResponse, error)
-	GetCommunityEvent(context.Context, *GetCommunityEventRequest) (*GetCommunityEventResponse, error)
-	IsUserValid(context.Context, *IsCommunityValidRequest) (*IsCommunityValidResponse, error)
-	mustEmbedUnimplementedCommunityServiceServer()
-}
-
-// UnimplementedCommunityServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedCommunityServiceServer struct {
-}
-
-func (UnimplementedCommunityServiceServer) CreateCommunity(context.Context, *CreateCommunityRequest) (*CreateCommunityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCommunity not implemented")
-}
-func (UnimplementedCommunityServiceServer) GetCommunityBy(context.Context, *GetCommunityRequest) (*GetCommunityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCommunityBy not implemented")
-}
-func (UnimplementedCommunityServiceServer) UpdateCommunity(context.Context, *UpdateCommunityRequest) (*UpdateCommunityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCommunity not implemented")
-}
-func (UnimplementedCommunityServiceServer) DeleteCommunity(context.Context, *DeleteCommunityRequest) (*DeleteCommunityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCommunity not implemented")
-}
-func (UnimplementedCommunityServiceServer) GetAllCommunity(context.Context, *GetAllCommunityRequest) (*GetAllCommunityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllCommunity not implemented")
-}
-func (UnimplementedCommunityServiceServer) JoinCommunity(context.Context, *JoinCommunityRequest) (*JoinCommunityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method JoinCommunity not implemented")
-}
-func (UnimplementedCommunityServiceServer) LeaveCommunity(context.Context, *LeaveCommunityRequest) (*LeaveCommunityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LeaveCommunity not implemented")
-}
-func (UnimplementedCommunityServiceServer) CreateCommunityEvent(context.Context, *CreateCommunityEventRequest) (*CreateCommunityEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCommunityEvent not implemented")
-}
-func (UnimplementedCommunityServiceServer) GetCommunityEvent(context.Context, *GetCommunityEventRequest) (*GetCommunityEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCommunityEvent not implemented")
-}
-func (UnimplementedCommunityServiceServer) IsUserValid(context.Context, *IsCommunityValidRequest) (*IsCommunityValidResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsUserValid not implemented")
-}
-func (UnimplementedCommunityServiceServer) mustEmbedUnimplementedCommunityServiceServer() {}
-
-// UnsafeCommunityServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to CommunityServiceServer will
-// result in compilation errors.
-type UnsafeCommunityServiceServer interface {
-	mustEmbedUnimplementedCommunityServiceServer()
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).ListEventPlantings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_ListEventPlantings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).ListEventPlantings(ctx, req.(*ListEventPlantingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterCommunityServiceServer(s grpc.ServiceRegistrar, srv CommunityServiceServer) {
-	s.RegisterService(&CommunityService_ServiceDesc, srv)
+func _CommunityService_GetCommunityGardenSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommunityGardenSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServiceServer).GetCommunityGardenSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommunityService_GetCommunityGardenSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServiceServer).GetCommunityGardenSummary(ctx, req.(*GetCommunityGardenSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _CommunityService_CreateCommunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCommunityRequest)
+func _CommunityService_ListJoinRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJoinRequestsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CommunityServiceServer).CreateCommunity(ctx, in)
+		return srv.(CommunityServiceServer).ListJoinRequests(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CommunityService_CreateCommunity_FullMethodName,
+		FullMethod: CommunityService_ListJoinRequests_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CommunityServiceServer).CreateCommunity(ctx, req.(*CreateCommunityRequest))
+		return srv.(CommunityServiceServer).ListJoinRequests(ctx, req.(*ListJoinRequestsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CommunityService_GetCommunityBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCommunityRequest)
+func _CommunityService_ApproveJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinRequestDecisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CommunityServiceServer).GetCommunityBy(ctx, in)
+		return srv.(CommunityServiceServer).ApproveJoinRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CommunityService_GetCommunityBy_FullMethodName,
+		FullMethod: CommunityService_ApproveJoinRequest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CommunityServiceServer).GetCommunityBy(ctx, req.(*GetCommunityRequest))
+		return srv.(CommunityServiceServer).ApproveJoinRequest(ctx, req.(*JoinRequestDecisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CommunityService_UpdateCommunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCommunityRequest)
+func _CommunityService_RejectJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinRequestDecisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CommunityServiceServer).UpdateCommunity(ctx, in)
+		return srv.(CommunityServiceServer).RejectJoinRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CommunityService_UpdateCommunity_FullMethodName,
+		FullMethod: CommunityService_RejectJoinRequest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CommunityServiceServer).UpdateCommunity(ctx, req.(*UpdateCommunityRequest))
+		return srv.(CommunityServiceServer).RejectJoinRequest(ctx, req.(*JoinRequestDecisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CommunityService_DeleteCommunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCommunityRequest)
+func _CommunityService_CreateCommunityInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommunityInviteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CommunityServiceServer).DeleteCommunity(ctx, in)
+		return srv.(CommunityServiceServer).CreateCommunityInvite(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CommunityService_DeleteCommunity_FullMethodName,
+		FullMethod: CommunityService_CreateCommunityInvite_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CommunityServiceServer).DeleteCommunity(ctx, req.(*DeleteCommunityRequest))
+		return srv.(CommunityServiceServer).CreateCommunityInvite(ctx, req.(*CreateCommunityInviteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CommunityService_GetAllCommunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllCommunityRequest)
+func _CommunityService_RemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CommunityServiceServer).GetAllCommunity(ctx, in)
+		return srv.(CommunityServiceServer).RemoveMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CommunityService_GetAllCommunity_FullMethodName,
+		FullMethod: CommunityService_RemoveMember_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CommunityServiceServer).GetAllCommunity(ctx, req.(*GetAllCommunityRequest))
+		return srv.(CommunityServiceServer).RemoveMember(ctx, req.(*RemoveMemberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CommunityService_JoinCommunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JoinCommunityRequest)
+func _CommunityService_BanMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CommunityServiceServer).JoinCommunity(ctx, in)
+		return srv.(CommunityServiceServer).BanMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CommunityService_JoinCommunity_FullMethodName,
+		FullMethod: CommunityService_BanMember_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CommunityServiceServer).JoinCommunity(ctx, req.(*JoinCommunityRequest))
+		return srv.(CommunityServiceServer).BanMember(ctx, req.(*BanMemberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CommunityService_LeaveCommunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LeaveCommunityRequest)
+func _CommunityService_UnbanMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CommunityServiceServer).LeaveCommunity(ctx, in)
+		return srv.(CommunityServiceServer).UnbanMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CommunityService_LeaveCommunity_FullMethodName,
+		FullMethod: CommunityService_UnbanMember_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CommunityServiceServer).LeaveCommunity(ctx, req.(*LeaveCommunityRequest))
+		return srv.(CommunityServiceServer).UnbanMember(ctx, req.(*UnbanMemberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CommunityService_CreateCommunityEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCommunityEventRequest)
+func _CommunityService_ListModerationActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModerationActionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CommunityServiceServer).CreateCommunityEvent(ctx, in)
+		return srv.(CommunityServiceServer).ListModerationActions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CommunityService_CreateCommunityEvent_FullMethodName,
+		FullMethod: CommunityService_ListModerationActions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CommunityServiceServer).CreateCommunityEvent(ctx, req.(*CreateCommunityEventRequest))
+		return srv.(CommunityServiceServer).ListModerationActions(ctx, req.(*ListModerationActionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CommunityService_GetCommunityEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCommunityEventRequest)
+func _CommunityService_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferOwnershipRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CommunityServiceServer).GetCommunityEvent(ctx, in)
+		return srv.(CommunityServiceServer).TransferOwnership(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CommunityService_GetCommunityEvent_FullMethodName,
+		FullMethod: CommunityService_TransferOwnership_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CommunityServiceServer).GetCommunityEvent(ctx, req.(*GetCommunityEventRequest))
+		return srv.(CommunityServiceServer).TransferOwnership(ctx, req.(*TransferOwnershipRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CommunityService_IsUserValid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IsCommunityValidRequest)
+func _CommunityService_RestoreCommunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCommunityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CommunityServiceServer).IsUserValid(ctx, in)
+		return srv.(CommunityServiceServer).RestoreCommunity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CommunityService_IsUserValid_FullMethodName,
+		FullMethod: CommunityService_RestoreCommunity_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CommunityServiceServer).IsUserValid(ctx, req.(*IsCommunityValidRequest))
+		return srv.(CommunityServiceServer).RestoreCommunity(ctx, req.(*RestoreCommunityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -446,6 +1644,146 @@ var CommunityService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsUserValid",
 			Handler:    _CommunityService_IsUserValid_Handler,
 		},
+		{
+			MethodName: "PromoteModerator",
+			Handler:    _CommunityService_PromoteModerator_Handler,
+		},
+		{
+			MethodName: "DemoteModerator",
+			Handler:    _CommunityService_DemoteModerator_Handler,
+		},
+		{
+			MethodName: "ListCommunityMembers",
+			Handler:    _CommunityService_ListCommunityMembers_Handler,
+		},
+		{
+			MethodName: "ListUserCommunities",
+			Handler:    _CommunityService_ListUserCommunities_Handler,
+		},
+		{
+			MethodName: "SearchCommunities",
+			Handler:    _CommunityService_SearchCommunities_Handler,
+		},
+		{
+			MethodName: "FindCommunitiesNearby",
+			Handler:    _CommunityService_FindCommunitiesNearby_Handler,
+		},
+		{
+			MethodName: "RsvpEvent",
+			Handler:    _CommunityService_RsvpEvent_Handler,
+		},
+		{
+			MethodName: "CancelRsvp",
+			Handler:    _CommunityService_CancelRsvp_Handler,
+		},
+		{
+			MethodName: "ListEventAttendees",
+			Handler:    _CommunityService_ListEventAttendees_Handler,
+		},
+		{
+			MethodName: "SetEventOccurrenceException",
+			Handler:    _CommunityService_SetEventOccurrenceException_Handler,
+		},
+		{
+			MethodName: "ExportCommunityCalendar",
+			Handler:    _CommunityService_ExportCommunityCalendar_Handler,
+		},
+		{
+			MethodName: "ListCommunityEvents",
+			Handler:    _CommunityService_ListCommunityEvents_Handler,
+		},
+		{
+			MethodName: "ListUpcomingEventsForUser",
+			Handler:    _CommunityService_ListUpcomingEventsForUser_Handler,
+		},
+		{
+			MethodName: "UpdateCommunityEvent",
+			Handler:    _CommunityService_UpdateCommunityEvent_Handler,
+		},
+		{
+			MethodName: "DeleteCommunityEvent",
+			Handler:    _CommunityService_DeleteCommunityEvent_Handler,
+		},
+		{
+			MethodName: "ListEventChanges",
+			Handler:    _CommunityService_ListEventChanges_Handler,
+		},
+		{
+			MethodName: "CreateSeedListing",
+			Handler:    _CommunityService_CreateSeedListing_Handler,
+		},
+		{
+			MethodName: "ListSeedListings",
+			Handler:    _CommunityService_ListSeedListings_Handler,
+		},
+		{
+			MethodName: "CloseSeedListing",
+			Handler:    _CommunityService_CloseSeedListing_Handler,
+		},
+		{
+			MethodName: "ClaimSeedListing",
+			Handler:    _CommunityService_ClaimSeedListing_Handler,
+		},
+		{
+			MethodName: "UpdateSeedMatchStatus",
+			Handler:    _CommunityService_UpdateSeedMatchStatus_Handler,
+		},
+		{
+			MethodName: "ListSeedMatches",
+			Handler:    _CommunityService_ListSeedMatches_Handler,
+		},
+		{
+			MethodName: "RecordEventPlantings",
+			Handler:    _CommunityService_RecordEventPlantings_Handler,
+		},
+		{
+			MethodName: "ListEventPlantings",
+			Handler:    _CommunityService_ListEventPlantings_Handler,
+		},
+		{
+			MethodName: "GetCommunityGardenSummary",
+			Handler:    _CommunityService_GetCommunityGardenSummary_Handler,
+		},
+		{
+			MethodName: "ListJoinRequests",
+			Handler:    _CommunityService_ListJoinRequests_Handler,
+		},
+		{
+			MethodName: "ApproveJoinRequest",
+			Handler:    _CommunityService_ApproveJoinRequest_Handler,
+		},
+		{
+			MethodName: "RejectJoinRequest",
+			Handler:    _CommunityService_RejectJoinRequest_Handler,
+		},
+		{
+			MethodName: "CreateCommunityInvite",
+			Handler:    _CommunityService_CreateCommunityInvite_Handler,
+		},
+		{
+			MethodName: "RemoveMember",
+			Handler:    _CommunityService_RemoveMember_Handler,
+		},
+		{
+			MethodName: "BanMember",
+			Handler:    _CommunityService_BanMember_Handler,
+		},
+		{
+			MethodName: "UnbanMember",
+			Handler:    _CommunityService_UnbanMember_Handler,
+		},
+		{
+			MethodName: "ListModerationActions",
+			Handler:    _CommunityService_ListModerationActions_Handler,
+		},
+		{
+			MethodName: "TransferOwnership",
+			Handler:    _CommunityService_TransferOwnership_Handler,
+		},
+		{
+			MethodName: "RestoreCommunity",
+			Handler:    _CommunityService_RestoreCommunity_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "CommunityService/Community.proto",
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS forum_comments;
+
+ALTER TABLE forum_posts ALTER COLUMN id DROP DEFAULT;
@@ -0,0 +1,11 @@
+ALTER TABLE forum_posts ALTER COLUMN id SET DEFAULT gen_random_uuid();
+
+CREATE TABLE forum_comments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    forum_id UUID REFERENCES forum_posts(id),
+    user_id UUID,
+    content TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE DEFAULT NULL
+);
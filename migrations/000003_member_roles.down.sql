@@ -0,0 +1,17 @@
+-- The old key allows one row per community, so only the owner, or failing that the
+-- earliest member, is kept. Every other membership is lost.
+DELETE FROM community_members
+WHERE ctid NOT IN (
+    SELECT DISTINCT ON (community_id) ctid
+    FROM community_members
+    ORDER BY community_id, role = 'owner' DESC, deleted_at IS NULL DESC, joined_at, user_id
+);
+
+ALTER TABLE community_members DROP CONSTRAINT community_members_pkey;
+
+ALTER TABLE community_members
+    DROP COLUMN role,
+    ALTER COLUMN user_id DROP NOT NULL,
+    ADD PRIMARY KEY (community_id);
+
+DROP TYPE IF EXISTS member_role;
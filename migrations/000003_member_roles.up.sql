@@ -0,0 +1,20 @@
+CREATE TYPE member_role AS ENUM ('owner', 'moderator', 'member');
+
+-- Rows without a user can't become memberships under the composite key.
+DELETE FROM community_members WHERE user_id IS NULL;
+
+ALTER TABLE community_members DROP CONSTRAINT community_members_pkey;
+
+ALTER TABLE community_members
+    ALTER COLUMN user_id SET NOT NULL,
+    ADD COLUMN role member_role NOT NULL DEFAULT 'member',
+    ADD PRIMARY KEY (community_id, user_id);
+
+-- Existing communities get their earliest active member as owner.
+UPDATE community_members SET role = 'owner'
+WHERE (community_id, user_id) IN (
+    SELECT DISTINCT ON (community_id) community_id, user_id
+    FROM community_members
+    WHERE deleted_at IS NULL
+    ORDER BY community_id, joined_at, user_id
+);
@@ -0,0 +1,14 @@
+CREATE TABLE community_membership_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    community_id UUID NOT NULL REFERENCES communities(id),
+    user_id UUID NOT NULL,
+    joined_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    left_at TIMESTAMP WITH TIME ZONE DEFAULT NULL
+);
+
+CREATE INDEX community_membership_history_member_idx
+    ON community_membership_history (community_id, user_id);
+
+INSERT INTO community_membership_history (community_id, user_id, joined_at, left_at)
+SELECT community_id, user_id, joined_at, deleted_at
+FROM community_members;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS communities_name_trgm_idx;
+DROP INDEX IF EXISTS communities_search_vector_idx;
+
+ALTER TABLE communities DROP COLUMN IF EXISTS search_vector;
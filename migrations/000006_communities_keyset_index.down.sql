@@ -0,0 +1 @@
+DROP INDEX IF EXISTS communities_created_at_id_idx;
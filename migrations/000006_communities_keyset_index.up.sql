@@ -0,0 +1 @@
+CREATE INDEX communities_created_at_id_idx ON communities (created_at, id) WHERE deleted_at IS NULL;
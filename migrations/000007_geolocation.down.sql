@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS communities_geography_idx;
+DROP INDEX IF EXISTS communities_coordinates_idx;
+
+ALTER TABLE events
+    DROP CONSTRAINT IF EXISTS events_coordinates_check,
+    DROP COLUMN IF EXISTS latitude,
+    DROP COLUMN IF EXISTS longitude;
+
+ALTER TABLE communities
+    DROP CONSTRAINT IF EXISTS communities_coordinates_check,
+    DROP COLUMN IF EXISTS latitude,
+    DROP COLUMN IF EXISTS longitude;
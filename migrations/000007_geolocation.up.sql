@@ -0,0 +1,30 @@
+ALTER TABLE communities
+    ADD COLUMN latitude DOUBLE PRECISION,
+    ADD COLUMN longitude DOUBLE PRECISION,
+    ADD CONSTRAINT communities_coordinates_check CHECK (
+        (latitude IS NULL AND longitude IS NULL) OR
+        (latitude BETWEEN -90 AND 90 AND longitude BETWEEN -180 AND 180)
+    );
+
+ALTER TABLE events
+    ADD COLUMN latitude DOUBLE PRECISION,
+    ADD COLUMN longitude DOUBLE PRECISION,
+    ADD CONSTRAINT events_coordinates_check CHECK (
+        (latitude IS NULL AND longitude IS NULL) OR
+        (latitude BETWEEN -90 AND 90 AND longitude BETWEEN -180 AND 180)
+    );
+
+-- Used by the bounding-box prefilter when PostGIS is not installed.
+CREATE INDEX communities_coordinates_idx ON communities (latitude, longitude)
+    WHERE deleted_at IS NULL AND latitude IS NOT NULL;
+
+-- With PostGIS, index the same geography expression the nearby query filters on.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'postgis') THEN
+        EXECUTE 'CREATE INDEX communities_geography_idx ON communities
+            USING GIST ((ST_SetSRID(ST_MakePoint(longitude, latitude), 4326)::geography))
+            WHERE deleted_at IS NULL AND latitude IS NOT NULL';
+    END IF;
+END
+$$;
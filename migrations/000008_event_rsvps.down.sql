@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS event_rsvps;
+
+ALTER TABLE events DROP COLUMN IF EXISTS capacity;
+
+DROP TYPE IF EXISTS rsvp_status;
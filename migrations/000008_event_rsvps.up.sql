@@ -0,0 +1,16 @@
+CREATE TYPE rsvp_status AS ENUM ('going', 'maybe', 'declined', 'waitlisted');
+
+ALTER TABLE events
+    ADD COLUMN capacity INT CHECK (capacity > 0);
+
+CREATE TABLE event_rsvps (
+    event_id UUID NOT NULL REFERENCES events(id),
+    user_id UUID NOT NULL,
+    status rsvp_status NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (event_id, user_id)
+);
+
+-- Waitlist promotion takes the oldest waitlisted RSVP first.
+CREATE INDEX event_rsvps_status_idx ON event_rsvps (event_id, status, updated_at);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS event_exceptions;
+
+ALTER TABLE events DROP COLUMN IF EXISTS recurrence_rule;
@@ -0,0 +1,16 @@
+ALTER TABLE events
+    ADD COLUMN recurrence_rule TEXT;
+
+-- One row per overridden occurrence of a recurring event, keyed by the start time the
+-- rule generated for it.
+CREATE TABLE event_exceptions (
+    event_id UUID NOT NULL REFERENCES events(id),
+    original_start TIMESTAMP WITH TIME ZONE NOT NULL,
+    cancelled BOOLEAN NOT NULL DEFAULT FALSE,
+    start_time TIMESTAMP WITH TIME ZONE,
+    end_time TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (event_id, original_start),
+    CHECK (cancelled OR (start_time IS NOT NULL AND end_time IS NOT NULL AND start_time < end_time))
+);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS events_community_start_idx;
@@ -0,0 +1,2 @@
+CREATE INDEX events_community_start_idx ON events (community_id, start_time, id)
+    WHERE deleted_at IS NULL;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS event_changes;
+
+ALTER TABLE events DROP COLUMN IF EXISTS cancellation_reason;
@@ -0,0 +1,14 @@
+ALTER TABLE events
+    ADD COLUMN cancellation_reason TEXT;
+
+CREATE TABLE event_changes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id),
+    changed_by UUID NOT NULL,
+    change_type VARCHAR(20) NOT NULL CHECK (change_type IN ('updated', 'cancelled')),
+    changes JSONB NOT NULL DEFAULT '[]',
+    reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX event_changes_event_idx ON event_changes (event_id, created_at);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS seed_matches;
+DROP TABLE IF EXISTS seed_listings;
+
+DROP TYPE IF EXISTS seed_match_status;
+DROP TYPE IF EXISTS seed_listing_status;
+DROP TYPE IF EXISTS seed_listing_kind;
@@ -0,0 +1,36 @@
+CREATE TYPE seed_listing_kind AS ENUM ('offer', 'want');
+CREATE TYPE seed_listing_status AS ENUM ('open', 'matched', 'closed');
+CREATE TYPE seed_match_status AS ENUM ('pending', 'accepted', 'declined', 'cancelled', 'completed');
+
+CREATE TABLE seed_listings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    community_id UUID NOT NULL REFERENCES communities(id),
+    user_id UUID NOT NULL,
+    kind seed_listing_kind NOT NULL,
+    species VARCHAR(100) NOT NULL,
+    quantity INT NOT NULL CHECK (quantity > 0),
+    garden_id UUID,
+    event_id UUID REFERENCES events(id),
+    notes TEXT,
+    status seed_listing_status NOT NULL DEFAULT 'open',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX seed_listings_community_idx ON seed_listings (community_id, status, kind, created_at);
+
+CREATE TABLE seed_matches (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    listing_id UUID NOT NULL REFERENCES seed_listings(id),
+    claimant_id UUID NOT NULL,
+    counter_listing_id UUID REFERENCES seed_listings(id),
+    quantity INT NOT NULL CHECK (quantity > 0),
+    status seed_match_status NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- A user can have only one live claim on a listing.
+CREATE UNIQUE INDEX seed_matches_active_claim_idx ON seed_matches (listing_id, claimant_id)
+    WHERE status IN ('pending', 'accepted');
+CREATE INDEX seed_matches_claimant_idx ON seed_matches (claimant_id, created_at);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS event_plantings;
+
+DROP INDEX IF EXISTS events_garden_idx;
+ALTER TABLE events DROP COLUMN IF EXISTS garden_id;
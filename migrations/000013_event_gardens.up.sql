@@ -0,0 +1,17 @@
+ALTER TABLE events
+    ADD COLUMN garden_id UUID;
+
+CREATE INDEX events_garden_idx ON events (garden_id) WHERE garden_id IS NOT NULL;
+
+CREATE TABLE event_plantings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id),
+    garden_id UUID NOT NULL,
+    plant_id UUID NOT NULL,
+    species VARCHAR(100) NOT NULL,
+    quantity INT NOT NULL CHECK (quantity > 0),
+    recorded_by UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX event_plantings_event_idx ON event_plantings (event_id, created_at);
@@ -0,0 +1,16 @@
+-- user_id and community_id are kept as text so that denials naming malformed ids are
+-- still recorded.
+CREATE TABLE authorization_denials (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id TEXT NOT NULL,
+    is_admin BOOLEAN NOT NULL DEFAULT FALSE,
+    permission VARCHAR(64) NOT NULL,
+    community_id TEXT,
+    role VARCHAR(20),
+    method TEXT,
+    reason TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX authorization_denials_user_idx ON authorization_denials (user_id, created_at);
+CREATE INDEX authorization_denials_community_idx ON authorization_denials (community_id, created_at);
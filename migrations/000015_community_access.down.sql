@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS community_invites;
+DROP TABLE IF EXISTS community_join_requests;
+DROP TYPE IF EXISTS join_request_status;
+
+ALTER TABLE communities DROP COLUMN IF EXISTS visibility;
+DROP TYPE IF EXISTS community_visibility;
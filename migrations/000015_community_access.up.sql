@@ -0,0 +1,31 @@
+CREATE TYPE community_visibility AS ENUM ('public', 'private', 'invite_only');
+
+ALTER TABLE communities ADD COLUMN visibility community_visibility NOT NULL DEFAULT 'public';
+
+CREATE TYPE join_request_status AS ENUM ('pending', 'approved', 'rejected');
+
+CREATE TABLE community_join_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    community_id UUID NOT NULL REFERENCES communities(id),
+    user_id UUID NOT NULL,
+    status join_request_status NOT NULL DEFAULT 'pending',
+    decided_by UUID,
+    decided_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- A user can have only one pending request per community.
+CREATE UNIQUE INDEX community_join_requests_pending_idx ON community_join_requests (community_id, user_id)
+    WHERE status = 'pending';
+CREATE INDEX community_join_requests_community_idx ON community_join_requests (community_id, status, created_at);
+
+CREATE TABLE community_invites (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    community_id UUID NOT NULL REFERENCES communities(id),
+    created_by UUID NOT NULL,
+    max_uses INT NOT NULL CHECK (max_uses > 0),
+    uses INT NOT NULL DEFAULT 0 CHECK (uses <= max_uses),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS moderation_log;
+DROP TABLE IF EXISTS community_bans;
+
+DROP TYPE IF EXISTS moderation_action;
@@ -0,0 +1,24 @@
+CREATE TYPE moderation_action AS ENUM ('remove', 'ban', 'unban');
+
+CREATE TABLE community_bans (
+    community_id UUID NOT NULL REFERENCES communities(id),
+    user_id UUID NOT NULL,
+    banned_by UUID NOT NULL,
+    reason TEXT NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE, -- NULL for a permanent ban
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (community_id, user_id)
+);
+
+CREATE TABLE moderation_log (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    community_id UUID NOT NULL REFERENCES communities(id),
+    moderator_id UUID NOT NULL,
+    target_user_id UUID NOT NULL,
+    action moderation_action NOT NULL,
+    reason TEXT,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX moderation_log_community_idx ON moderation_log (community_id, created_at);
@@ -0,0 +1,6 @@
+DELETE FROM event_changes WHERE change_type = 'restored';
+ALTER TABLE event_changes DROP CONSTRAINT event_changes_change_type_check;
+ALTER TABLE event_changes ADD CONSTRAINT event_changes_change_type_check
+    CHECK (change_type IN ('updated', 'cancelled'));
+
+ALTER TABLE forum_posts DROP COLUMN IF EXISTS archived_at;
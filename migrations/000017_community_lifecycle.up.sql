@@ -0,0 +1,6 @@
+-- Posts are archived when their community is deleted and unarchived if it is restored.
+ALTER TABLE forum_posts ADD COLUMN archived_at TIMESTAMP WITH TIME ZONE;
+
+ALTER TABLE event_changes DROP CONSTRAINT event_changes_change_type_check;
+ALTER TABLE event_changes ADD CONSTRAINT event_changes_change_type_check
+    CHECK (change_type IN ('updated', 'cancelled', 'restored'));
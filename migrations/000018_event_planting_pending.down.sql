@@ -0,0 +1,2 @@
+DELETE FROM event_plantings WHERE plant_id IS NULL;
+ALTER TABLE event_plantings ALTER COLUMN plant_id SET NOT NULL;
@@ -0,0 +1,3 @@
+-- A planting is recorded before its plant is created in the Garden Management Service,
+-- and plant_id stays NULL until that succeeds.
+ALTER TABLE event_plantings ALTER COLUMN plant_id DROP NOT NULL;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS community_members_one_owner_idx;
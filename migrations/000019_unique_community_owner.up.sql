@@ -0,0 +1,12 @@
+-- A community has exactly one active owner. Any extra owner rows are demoted to
+-- moderator first, keeping the earliest joined.
+UPDATE community_members m SET role = 'moderator', updated_at = NOW()
+WHERE m.role = 'owner' AND m.deleted_at IS NULL
+    AND EXISTS (
+        SELECT 1 FROM community_members o
+        WHERE o.community_id = m.community_id AND o.role = 'owner' AND o.deleted_at IS NULL
+            AND (coalesce(o.joined_at, '-infinity'), o.user_id) < (coalesce(m.joined_at, '-infinity'), m.user_id)
+    );
+
+CREATE UNIQUE INDEX community_members_one_owner_idx ON community_members (community_id)
+    WHERE role = 'owner' AND deleted_at IS NULL;
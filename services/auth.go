@@ -0,0 +1,39 @@
+package services
+
+import (
+	"context"
+
+	"github.com/Projects/ComunityService/auth"
+	com "github.com/Projects/ComunityService/genproto/CommunityService"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PublicMethods are the read-only methods that may be called without a bearer token.
+var PublicMethods = map[string]bool{
+	com.CommunityService_GetCommunityBy_FullMethodName:          true,
+	com.CommunityService_GetAllCommunity_FullMethodName:         true,
+	com.CommunityService_IsUserValid_FullMethodName:             true,
+	com.CommunityService_SearchCommunities_FullMethodName:       true,
+	com.CommunityService_FindCommunitiesNearby_FullMethodName:   true,
+	com.CommunityService_GetCommunityEvent_FullMethodName:       true,
+	com.CommunityService_ListCommunityEvents_FullMethodName:     true,
+	com.CommunityService_ExportCommunityCalendar_FullMethodName: true,
+	com.ForumService_GetForum_FullMethodName:                    true,
+}
+
+// callerID returns the user a request acts as. That is the authenticated caller, unless
+// an admin names another user in the request.
+func callerID(ctx context.Context, action, requested string) (string, error) {
+	id, ok := auth.FromContext(ctx)
+	if !ok {
+		return "", status.Errorf(codes.Unauthenticated, "%s: request is not authenticated", action)
+	}
+	if requested == "" || requested == id.UserID {
+		return id.UserID, nil
+	}
+	if !id.IsAdmin() {
+		return "", status.Errorf(codes.PermissionDenied, "%s: user %s may not act as user %s", action, id.UserID, requested)
+	}
+	return requested, nil
+}
@@ -0,0 +1,204 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	com "github.com/Projects/ComunityService/genproto/CommunityService"
+	"github.com/Projects/ComunityService/storage"
+)
+
+const (
+	calendarContentType = "text/calendar; charset=utf-8"
+	calendarProdID      = "-//CommunityService//Community Calendar//EN"
+	// calendarUIDDomain makes event UIDs globally unique; it must never change or
+	// subscribed calendars will see every event as new.
+	calendarUIDDomain = "community-service"
+	// calendarHistory is how far back one-off events are kept in the feed.
+	calendarHistory = 180 * 24 * time.Hour
+	icsTimeLayout   = "20060102T150405Z"
+	icsMaxLineBytes = 75
+)
+
+// eventCategories maps the event_type enum to iCalendar CATEGORIES values.
+var eventCategories = map[string]string{
+	"workshop":           "Workshop",
+	"seed_exchange":      "Seed Exchange",
+	"community_planting": "Community Planting",
+	"farmers_market":     "Farmers Market",
+}
+
+// calendarWriter accumulates content lines, folding and terminating them per RFC 5545.
+type calendarWriter struct {
+	b strings.Builder
+}
+
+func (w *calendarWriter) line(name, value string) {
+	w.fold(name + ":" + value)
+}
+
+func (w *calendarWriter) text(name, value string) {
+	if value != "" {
+		w.line(name, escapeText(value))
+	}
+}
+
+func (w *calendarWriter) time(name string, t time.Time) {
+	w.line(name, t.UTC().Format(icsTimeLayout))
+}
+
+// fold splits a content line into chunks of at most 75 octets without breaking UTF-8
+// sequences; continuation lines start with a single space.
+func (w *calendarWriter) fold(line string) {
+	limit := icsMaxLineBytes
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		w.b.WriteString(line[:cut])
+		w.b.WriteString("\r\n ")
+		line = line[cut:]
+		limit = icsMaxLineBytes - 1
+	}
+	w.b.WriteString(line)
+	w.b.WriteString("\r\n")
+}
+
+func escapeText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(s)
+}
+
+func eventUID(eventID string) string {
+	return eventID + "@" + calendarUIDDomain
+}
+
+// renderCalendar renders the community's events as a VCALENDAR. Soft-deleted events are
+// kept with STATUS:CANCELLED so subscribers remove them, cancelled occurrences become
+// EXDATEs and moved occurrences are emitted as overrides with a RECURRENCE-ID.
+func renderCalendar(community *storage.Community, events []*storage.Event, exceptions map[string][]*storage.EventException) string {
+	w := &calendarWriter{}
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.line("PRODID", calendarProdID)
+	w.line("CALSCALE", "GREGORIAN")
+	w.line("METHOD", "PUBLISH")
+	w.text("X-WR-CALNAME", community.Name)
+	w.text("X-WR-CALDESC", community.Description)
+
+	for _, event := range events {
+		writeEvent(w, event, exceptions[event.ID])
+	}
+
+	w.line("END", "VCALENDAR")
+	return w.b.String()
+}
+
+func writeEvent(w *calendarWriter, event *storage.Event, exceptions []*storage.EventException) {
+	writeEventHeader(w, event)
+	w.time("DTSTART", event.StartTime)
+	w.time("DTEND", event.EndTime)
+	if event.RecurrenceRule != "" {
+		w.line("RRULE", strings.TrimPrefix(event.RecurrenceRule, "RRULE:"))
+		for _, ex := range exceptions {
+			if ex.Cancelled {
+				w.time("EXDATE", ex.OriginalStart)
+			}
+		}
+	}
+	w.line("END", "VEVENT")
+
+	if event.DeletedAt != nil {
+		return
+	}
+	for _, ex := range exceptions {
+		if ex.Cancelled || ex.StartTime == nil || ex.EndTime == nil {
+			continue
+		}
+		writeEventHeader(w, event)
+		w.time("RECURRENCE-ID", ex.OriginalStart)
+		w.time("DTSTART", *ex.StartTime)
+		w.time("DTEND", *ex.EndTime)
+		w.line("END", "VEVENT")
+	}
+}
+
+// writeEventHeader opens a VEVENT and writes the properties shared by an event and its
+// per-occurrence overrides.
+func writeEventHeader(w *calendarWriter, event *storage.Event) {
+	lastModified := event.UpdatedAt
+	status := "CONFIRMED"
+	if event.DeletedAt != nil {
+		lastModified = *event.DeletedAt
+		status = "CANCELLED"
+	}
+
+	w.line("BEGIN", "VEVENT")
+	w.line("UID", eventUID(event.ID))
+	w.time("DTSTAMP", lastModified)
+	w.time("CREATED", event.CreatedAt)
+	w.time("LAST-MODIFIED", lastModified)
+	w.text("SUMMARY", event.Name)
+	w.text("DESCRIPTION", event.Description)
+	w.text("LOCATION", event.Location)
+	if event.Latitude != nil && event.Longitude != nil {
+		w.line("GEO", fmt.Sprintf("%f;%f", *event.Latitude, *event.Longitude))
+	}
+	if category, ok := eventCategories[event.EventType]; ok {
+		w.text("CATEGORIES", category)
+	}
+	w.line("STATUS", status)
+}
+
+// communityCalendar loads a community's events and renders them as iCalendar.
+func (cs *communityService) communityCalendar(ctx context.Context, communityID string) (string, error) {
+	community, err := visibleCommunity(ctx, cs.storage, communityID)
+	if err != nil {
+		return "", err
+	}
+
+	events, err := cs.storage.Event().ListCalendarEvents(ctx, communityID, time.Now().Add(-calendarHistory))
+	if err != nil {
+		return "", err
+	}
+
+	exceptions := map[string][]*storage.EventException{}
+	for _, event := range events {
+		if event.RecurrenceRule == "" {
+			continue
+		}
+		exceptions[event.ID], err = cs.storage.Event().ListEventExceptions(ctx, event.ID)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return renderCalendar(community, events, exceptions), nil
+}
+
+func (cs *communityService) ExportCommunityCalendar(ctx context.Context, calReq *com.ExportCommunityCalendarRequest) (*com.ExportCommunityCalendarResponse, error) {
+	const action = "error exporting community calendar"
+	if calReq.CommunityId == "" {
+		return nil, invalidArgument(action, "community_id", "community ID is empty")
+	}
+
+	calendar, err := cs.communityCalendar(ctx, calReq.CommunityId)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	return &com.ExportCommunityCalendarResponse{
+		Calendar:    calendar,
+		ContentType: calendarContentType,
+	}, nil
+}
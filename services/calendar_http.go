@@ -0,0 +1,46 @@
+package services
+
+import (
+	"log"
+	"net/http"
+
+	com "github.com/Projects/ComunityService/genproto/CommunityService"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NewCalendarHandler serves GET /communities/{id}/calendar.ics so that the API gateway can
+// proxy calendar subscriptions, which calendar apps fetch over plain HTTP.
+func NewCalendarHandler(cs *communityService) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /communities/{id}/calendar.ics", func(w http.ResponseWriter, r *http.Request) {
+		res, err := cs.ExportCommunityCalendar(r.Context(), &com.ExportCommunityCalendarRequest{CommunityId: r.PathValue("id")})
+		if err != nil {
+			st := status.Convert(err)
+			http.Error(w, st.Message(), httpStatus(st.Code()))
+			return
+		}
+
+		w.Header().Set("Content-Type", res.ContentType)
+		w.Header().Set("Content-Disposition", `inline; filename="calendar.ics"`)
+		if _, err := w.Write([]byte(res.Calendar)); err != nil {
+			log.Printf("failed to write calendar: %v", err)
+		}
+	})
+	return mux
+}
+
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
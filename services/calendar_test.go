@@ -0,0 +1,152 @@
+package services
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Projects/ComunityService/storage"
+)
+
+func TestEscapeText(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "plain", in: "Seed swap", want: "Seed swap"},
+		{name: "semicolon and comma", in: "Tomatoes; beans, peas", want: `Tomatoes\; beans\, peas`},
+		{name: "backslash", in: `C:\seeds`, want: `C:\\seeds`},
+		{name: "escaped newline stays literal", in: `a\nb`, want: `a\\nb`},
+		{name: "newline", in: "line one\nline two", want: `line one\nline two`},
+		{name: "CRLF", in: "line one\r\nline two", want: `line one\nline two`},
+		{name: "bare CR", in: "line one\rline two", want: `line one\nline two`},
+		{name: "colon is left alone", in: "Start: 10am", want: "Start: 10am"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := escapeText(tt.in); got != tt.want {
+				t.Errorf("escapeText(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFold(t *testing.T) {
+	tests := []struct {
+		name      string
+		line      string
+		wantLines int
+	}{
+		{name: "short", line: "SUMMARY:Seed swap", wantLines: 1},
+		{name: "exactly 75 octets", line: strings.Repeat("a", 75), wantLines: 1},
+		{name: "76 octets", line: strings.Repeat("a", 76), wantLines: 2},
+		// Continuation lines hold 74 octets after their leading space.
+		{name: "three lines", line: strings.Repeat("a", 75+74+1), wantLines: 3},
+		{name: "two byte runes across the limit", line: "X:" + strings.Repeat("é", 60), wantLines: 2},
+		{name: "three byte runes", line: "X:" + strings.Repeat("€", 60), wantLines: 3},
+		{name: "four byte runes", line: "X:" + strings.Repeat("🌱", 40), wantLines: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &calendarWriter{}
+			w.fold(tt.line)
+			out := w.b.String()
+
+			if !strings.HasSuffix(out, "\r\n") {
+				t.Fatalf("output %q does not end in CRLF", out)
+			}
+			lines := strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n")
+			if len(lines) != tt.wantLines {
+				t.Errorf("got %d lines, want %d", len(lines), tt.wantLines)
+			}
+			for i, l := range lines {
+				if len(l) > icsMaxLineBytes {
+					t.Errorf("line %d is %d octets", i, len(l))
+				}
+				if !utf8.ValidString(l) {
+					t.Errorf("line %d splits a rune: %q", i, l)
+				}
+				if i > 0 && !strings.HasPrefix(l, " ") {
+					t.Errorf("continuation line %d does not start with a space", i)
+				}
+			}
+			if unfolded := strings.ReplaceAll(strings.TrimSuffix(out, "\r\n"), "\r\n ", ""); unfolded != tt.line {
+				t.Errorf("unfolded line = %q, want %q", unfolded, tt.line)
+			}
+		})
+	}
+}
+
+func TestRenderCalendar(t *testing.T) {
+	start := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	moved := start.AddDate(0, 0, 7).Add(2 * time.Hour)
+	deletedAt := start.Add(-time.Hour)
+	community := &storage.Community{Name: "Riverside, North", Description: "Allotments; and more"}
+
+	series := &storage.Event{
+		ID: "e1", Name: "Weekly dig", EventType: "workshop",
+		StartTime: start, EndTime: start.Add(time.Hour),
+		RecurrenceRule: "RRULE:FREQ=WEEKLY",
+		Description:    strings.Repeat("Bring gloves, spades and seedlings. ", 5) + "Tea 🍵 after.\nAll welcome.",
+	}
+	cancelled := &storage.Event{
+		ID: "e2", Name: "Market", EventType: "farmers_market",
+		StartTime: start.AddDate(0, 0, 2), EndTime: start.AddDate(0, 0, 2).Add(time.Hour),
+		DeletedAt: &deletedAt,
+	}
+	exceptions := map[string][]*storage.EventException{
+		"e1": {
+			{OriginalStart: start.AddDate(0, 0, 14), Cancelled: true},
+			{OriginalStart: start.AddDate(0, 0, 7), StartTime: &moved, EndTime: timePtr(moved.Add(time.Hour))},
+		},
+	}
+
+	out := renderCalendar(community, []*storage.Event{series, cancelled}, exceptions)
+
+	if strings.Count(out, "\n") != strings.Count(out, "\r\n") {
+		t.Fatalf("calendar has line feeds outside CRLF")
+	}
+	if strings.Contains(strings.ReplaceAll(out, "\r\n", ""), "\r") {
+		t.Fatalf("calendar has a bare carriage return")
+	}
+	for i, l := range strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n") {
+		if len(l) > icsMaxLineBytes {
+			t.Errorf("line %d is %d octets: %q", i, len(l), l)
+		}
+	}
+
+	unfolded := strings.ReplaceAll(out, "\r\n ", "")
+	lines := strings.Split(strings.TrimSuffix(unfolded, "\r\n"), "\r\n")
+	if lines[0] != "BEGIN:VCALENDAR" || lines[len(lines)-1] != "END:VCALENDAR" {
+		t.Errorf("calendar is not wrapped in a VCALENDAR: %q ... %q", lines[0], lines[len(lines)-1])
+	}
+
+	tests := []struct {
+		name string
+		want string
+		n    int
+	}{
+		{name: "calendar name", want: `X-WR-CALNAME:Riverside\, North`, n: 1},
+		{name: "calendar description", want: `X-WR-CALDESC:Allotments\; and more`, n: 1},
+		{name: "events and the override", want: "BEGIN:VEVENT", n: 3},
+		{name: "rule without its prefix", want: "RRULE:FREQ=WEEKLY", n: 1},
+		{name: "cancelled occurrence", want: "EXDATE:20250315T100000Z", n: 1},
+		{name: "moved occurrence", want: "RECURRENCE-ID:20250308T100000Z", n: 1},
+		{name: "moved start", want: "DTSTART:20250308T120000Z", n: 1},
+		{name: "override shares the UID", want: "UID:e1@" + calendarUIDDomain, n: 2},
+		{name: "deleted event", want: "STATUS:CANCELLED", n: 1},
+		{name: "category", want: "CATEGORIES:Farmers Market", n: 1},
+		{name: "description keeps its runes and newline", want: `Tea 🍵 after.\nAll welcome.`, n: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := strings.Count(unfolded, tt.want); got != tt.n {
+				t.Errorf("found %q %d times, want %d", tt.want, got, tt.n)
+			}
+		})
+	}
+}
@@ -0,0 +1,257 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Projects/ComunityService/auth"
+	com "github.com/Projects/ComunityService/genproto/CommunityService"
+	"github.com/Projects/ComunityService/storage"
+)
+
+const (
+	defaultInviteTTL = 7 * 24 * time.Hour
+	maxInviteTTL     = 30 * 24 * time.Hour
+	maxInviteUses    = 1000
+)
+
+// joinRequestStatuses mirrors the join_request_status enum in the community_join_requests table.
+var joinRequestStatuses = map[string]bool{
+	storage.JoinRequestPending:  true,
+	storage.JoinRequestApproved: true,
+	storage.JoinRequestRejected: true,
+}
+
+// visibleTo returns the user private communities are listed to: nil for admins, who see
+// them all, and an empty ID for anonymous callers, who see none.
+func visibleTo(ctx context.Context) *string {
+	id, ok := auth.FromContext(ctx)
+	if !ok {
+		anonymous := ""
+		return &anonymous
+	}
+	if id.IsAdmin() {
+		return nil
+	}
+	return &id.UserID
+}
+
+// checkVisible hides a private community from callers that aren't members of it by
+// reporting it as not found, so its ID can't be probed.
+func checkVisible(ctx context.Context, st storage.IStorage, community *storage.Community) error {
+	if community.Visibility != storage.VisibilityPrivate {
+		return nil
+	}
+	if userID := visibleTo(ctx); userID == nil {
+		return nil
+	} else if *userID != "" {
+		isMember, err := st.Member().IsCommunityMember(ctx, community.ID, *userID)
+		if err != nil {
+			return err
+		}
+		if isMember {
+			return nil
+		}
+	}
+	return storage.NewError(storage.ErrNotFound, "community", "community %s not found", community.ID)
+}
+
+// visibleCommunity loads a community the caller is allowed to see.
+func visibleCommunity(ctx context.Context, st storage.IStorage, communityID string) (*storage.Community, error) {
+	community, err := st.Community().GetCommunity(ctx, communityID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVisible(ctx, st, community); err != nil {
+		return nil, err
+	}
+	return community, nil
+}
+
+// contentVisible reports whether the caller may see an event or post of the community.
+// Content outlives its community, so a deleted community is checked the same way.
+func contentVisible(ctx context.Context, st storage.IStorage, communityID string) (bool, error) {
+	community, err := st.Community().GetCommunity(ctx, communityID)
+	if errors.Is(err, storage.ErrNotFound) {
+		deleted, err := st.Community().GetDeletedCommunity(ctx, communityID)
+		if errors.Is(err, storage.ErrNotFound) {
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+		community = &deleted.Community
+	} else if err != nil {
+		return false, err
+	}
+
+	err = checkVisible(ctx, st, community)
+	if errors.Is(err, storage.ErrNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// checkEventVisible reports an event of a community hidden from the caller as not found.
+func checkEventVisible(ctx context.Context, st storage.IStorage, action string, event *storage.Event) error {
+	visible, err := contentVisible(ctx, st, event.CommunityID)
+	if err != nil {
+		return toStatus(action, err)
+	}
+	if !visible {
+		return toStatus(action, storage.NewError(storage.ErrNotFound, "event", "event %s not found", event.ID))
+	}
+	return nil
+}
+
+func RepoToProtoJoinRequest(repoRequest *storage.JoinRequest) *com.JoinRequest {
+	request := &com.JoinRequest{
+		Id:          repoRequest.ID,
+		CommunityId: repoRequest.CommunityID,
+		UserId:      repoRequest.UserID,
+		Status:      repoRequest.Status,
+		DecidedBy:   repoRequest.DecidedBy,
+		CreatedAt:   repoRequest.CreatedAt.Format(timeLayout),
+		UpdatedAt:   repoRequest.UpdatedAt.Format(timeLayout),
+	}
+	if repoRequest.DecidedAt != nil {
+		request.DecidedAt = repoRequest.DecidedAt.Format(timeLayout)
+	}
+	return request
+}
+
+func RepoToProtoCommunityInvite(repoInvite *storage.CommunityInvite, code string) *com.CommunityInvite {
+	return &com.CommunityInvite{
+		Id:          repoInvite.ID,
+		CommunityId: repoInvite.CommunityID,
+		CreatedBy:   repoInvite.CreatedBy,
+		Code:        code,
+		MaxUses:     repoInvite.MaxUses,
+		Uses:        repoInvite.Uses,
+		ExpiresAt:   repoInvite.ExpiresAt.Format(timeLayout),
+		CreatedAt:   repoInvite.CreatedAt.Format(timeLayout),
+	}
+}
+
+func (cs *communityService) ListJoinRequests(ctx context.Context, listReq *com.ListJoinRequestsRequest) (*com.ListJoinRequestsResponse, error) {
+	const action = "error listing join requests"
+	userID, err := callerID(ctx, action, listReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if listReq.CommunityId == "" {
+		return nil, invalidArgument(action, "community_id", "community ID is empty")
+	}
+	requestStatus := listReq.Status
+	if requestStatus == "" {
+		requestStatus = storage.JoinRequestPending
+	}
+	if !joinRequestStatuses[requestStatus] {
+		return nil, invalidArgument(action, "status", fmt.Sprintf("invalid status %q, expected pending, approved or rejected", listReq.Status))
+	}
+	if err := authorize(ctx, cs.storage, action, permJoinRequestReview, listReq.CommunityId, userID); err != nil {
+		return nil, err
+	}
+
+	limit, offset := normalizePage(listReq.Limit, listReq.Offset)
+	requestsRes, err := cs.storage.Member().ListJoinRequests(ctx, &storage.JoinRequestFilter{
+		CommunityID: listReq.CommunityId,
+		Status:      requestStatus,
+		Limit:       limit,
+		Offset:      offset,
+	})
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	var requests []*com.JoinRequest
+	for _, request := range requestsRes {
+		requests = append(requests, RepoToProtoJoinRequest(request))
+	}
+
+	return &com.ListJoinRequestsResponse{JoinRequests: requests}, nil
+}
+
+func (cs *communityService) ApproveJoinRequest(ctx context.Context, decisionReq *com.JoinRequestDecisionRequest) (*com.JoinRequestDecisionResponse, error) {
+	return cs.decideJoinRequest(ctx, decisionReq, storage.JoinRequestApproved)
+}
+
+func (cs *communityService) RejectJoinRequest(ctx context.Context, decisionReq *com.JoinRequestDecisionRequest) (*com.JoinRequestDecisionResponse, error) {
+	return cs.decideJoinRequest(ctx, decisionReq, storage.JoinRequestRejected)
+}
+
+func (cs *communityService) decideJoinRequest(ctx context.Context, decisionReq *com.JoinRequestDecisionRequest, decision string) (*com.JoinRequestDecisionResponse, error) {
+	const action = "error deciding join request"
+	userID, err := callerID(ctx, action, decisionReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if decisionReq.RequestId == "" {
+		return nil, invalidArgument(action, "request_id", "request ID is empty")
+	}
+
+	request, err := cs.storage.Member().GetJoinRequest(ctx, decisionReq.RequestId)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+	if err := authorize(ctx, cs.storage, action, permJoinRequestReview, request.CommunityID, userID); err != nil {
+		return nil, err
+	}
+
+	requestRes, err := cs.storage.Member().DecideJoinRequest(ctx, request.ID, decision, userID)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	return &com.JoinRequestDecisionResponse{JoinRequest: RepoToProtoJoinRequest(requestRes)}, nil
+}
+
+func (cs *communityService) CreateCommunityInvite(ctx context.Context, inviteReq *com.CreateCommunityInviteRequest) (*com.CreateCommunityInviteResponse, error) {
+	const action = "error creating community invite"
+	userID, err := callerID(ctx, action, inviteReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if inviteReq.CommunityId == "" {
+		return nil, invalidArgument(action, "community_id", "community ID is empty")
+	}
+
+	maxUses := inviteReq.MaxUses
+	if maxUses == 0 {
+		maxUses = 1
+	}
+	if maxUses < 0 || maxUses > maxInviteUses {
+		return nil, invalidArgument(action, "max_uses", fmt.Sprintf("max_uses must be between 1 and %d", maxInviteUses))
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(defaultInviteTTL)
+	if inviteReq.ExpiresAt != "" {
+		expiresAt, err = time.Parse(timeLayout, inviteReq.ExpiresAt)
+		if err != nil {
+			return nil, invalidArgument(action, "expires_at", fmt.Sprintf("invalid expires_at %q: %v", inviteReq.ExpiresAt, err))
+		}
+	}
+	if !expiresAt.After(now) || expiresAt.Sub(now) > maxInviteTTL {
+		return nil, invalidArgument(action, "expires_at", fmt.Sprintf("expires_at must be in the next %d days", int(maxInviteTTL.Hours()/24)))
+	}
+	if err := authorize(ctx, cs.storage, action, permInviteCreate, inviteReq.CommunityId, userID); err != nil {
+		return nil, err
+	}
+
+	// The code carries the expiry in whole seconds, so store it the same way.
+	expiresAt = expiresAt.Truncate(time.Second)
+	inviteRes, err := cs.storage.Member().CreateInvite(ctx, &storage.CommunityInvite{
+		CommunityID: inviteReq.CommunityId,
+		CreatedBy:   userID,
+		MaxUses:     maxUses,
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	code := signInviteCode(cs.inviteSecret, inviteRes.ID, inviteRes.ExpiresAt)
+	return &com.CreateCommunityInviteResponse{Invite: RepoToProtoCommunityInvite(inviteRes, code)}, nil
+}
@@ -0,0 +1,225 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	com "github.com/Projects/ComunityService/genproto/CommunityService"
+	"github.com/Projects/ComunityService/storage"
+	"google.golang.org/grpc/codes"
+)
+
+func TestRedeemInvite(t *testing.T) {
+	type redeem struct {
+		userID   string
+		want     codes.Code
+		isMember bool
+	}
+
+	tests := []struct {
+		name    string
+		maxUses int32
+		// code rewrites the issued code before it is redeemed; nil keeps it.
+		code    func(t *testing.T, st storage.IStorage, communityID, issued string) string
+		banned  string
+		redeems []redeem
+	}{
+		{
+			name:    "valid code joins",
+			redeems: []redeem{{aliceID, codes.OK, true}},
+		},
+		{
+			name:    "single use code is used up",
+			redeems: []redeem{{aliceID, codes.OK, true}, {bobID, codes.InvalidArgument, false}},
+		},
+		{
+			name:    "code with uses left",
+			maxUses: 2,
+			redeems: []redeem{{aliceID, codes.OK, true}, {bobID, codes.OK, true}, {carolID, codes.InvalidArgument, false}},
+		},
+		{
+			name:    "member redeems again",
+			maxUses: 2,
+			redeems: []redeem{{aliceID, codes.OK, true}, {aliceID, codes.AlreadyExists, true}},
+		},
+		{
+			name: "tampered code",
+			code: func(t *testing.T, _ storage.IStorage, _, issued string) string {
+				return issued[:len(issued)-1] + "x"
+			},
+			redeems: []redeem{{aliceID, codes.InvalidArgument, false}},
+		},
+		{
+			name: "expired code",
+			code: func(t *testing.T, st storage.IStorage, communityID, _ string) string {
+				past := time.Now().Add(-time.Hour)
+				invite, err := st.Member().CreateInvite(context.Background(), &storage.CommunityInvite{
+					CommunityID: communityID, CreatedBy: ownerID, MaxUses: 1, ExpiresAt: past,
+				})
+				if err != nil {
+					t.Fatalf("CreateInvite: %v", err)
+				}
+				return signInviteCode(testInviteSecret, invite.ID, past)
+			},
+			redeems: []redeem{{aliceID, codes.InvalidArgument, false}},
+		},
+		{
+			name:    "banned user",
+			banned:  aliceID,
+			redeems: []redeem{{aliceID, codes.PermissionDenied, false}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs, st := newTestService(t)
+			communityID := newTestCommunity(t, cs, storage.VisibilityInviteOnly)
+
+			inviteRes, err := cs.CreateCommunityInvite(as(ownerID), &com.CreateCommunityInviteRequest{CommunityId: communityID, MaxUses: tt.maxUses})
+			if err != nil {
+				t.Fatalf("CreateCommunityInvite: %v", err)
+			}
+			code := inviteRes.Invite.Code
+			if tt.code != nil {
+				code = tt.code(t, st, communityID, code)
+			}
+			if tt.banned != "" {
+				_, err := cs.BanMember(as(ownerID), &com.BanMemberRequest{CommunityId: communityID, MemberId: tt.banned, Reason: "spam"})
+				if err != nil {
+					t.Fatalf("BanMember: %v", err)
+				}
+			}
+
+			for i, r := range tt.redeems {
+				_, err := cs.JoinCommunity(as(r.userID), &com.JoinCommunityRequest{CommunityId: communityID, InviteCode: code})
+				wantCode(t, err, r.want)
+
+				isMember, err := st.Member().IsCommunityMember(context.Background(), communityID, r.userID)
+				if err != nil {
+					t.Fatalf("IsCommunityMember: %v", err)
+				}
+				if isMember != r.isMember {
+					t.Fatalf("redeem %d: member = %v, want %v", i, isMember, r.isMember)
+				}
+			}
+		})
+	}
+}
+
+func TestInviteCodeForOtherCommunity(t *testing.T) {
+	cs, _ := newTestService(t)
+	communityID := newTestCommunity(t, cs, storage.VisibilityInviteOnly)
+	otherID := newTestCommunity(t, cs, storage.VisibilityInviteOnly)
+
+	inviteRes, err := cs.CreateCommunityInvite(as(ownerID), &com.CreateCommunityInviteRequest{CommunityId: communityID})
+	if err != nil {
+		t.Fatalf("CreateCommunityInvite: %v", err)
+	}
+	_, err = cs.JoinCommunity(as(aliceID), &com.JoinCommunityRequest{CommunityId: otherID, InviteCode: inviteRes.Invite.Code})
+	wantCode(t, err, codes.NotFound)
+}
+
+func TestJoinByVisibility(t *testing.T) {
+	tests := []struct {
+		name        string
+		visibility  string
+		want        codes.Code
+		isMember    bool
+		wantRequest bool
+	}{
+		{name: "public community joins", visibility: storage.VisibilityPublic, want: codes.OK, isMember: true},
+		{name: "private community queues a join request", visibility: storage.VisibilityPrivate, want: codes.OK, wantRequest: true},
+		{name: "invite only community needs a code", visibility: storage.VisibilityInviteOnly, want: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs, st := newTestService(t)
+			communityID := newTestCommunity(t, cs, tt.visibility)
+
+			res, err := cs.JoinCommunity(as(aliceID), &com.JoinCommunityRequest{CommunityId: communityID})
+			wantCode(t, err, tt.want)
+			if got := res.GetJoinRequest() != nil; got != tt.wantRequest {
+				t.Errorf("join request = %v, want %v", got, tt.wantRequest)
+			}
+
+			isMember, err := st.Member().IsCommunityMember(context.Background(), communityID, aliceID)
+			if err != nil {
+				t.Fatalf("IsCommunityMember: %v", err)
+			}
+			if isMember != tt.isMember {
+				t.Errorf("member = %v, want %v", isMember, tt.isMember)
+			}
+		})
+	}
+}
+
+func TestJoinRequestPending(t *testing.T) {
+	cs, _ := newTestService(t)
+	communityID := newTestCommunity(t, cs, storage.VisibilityPrivate)
+
+	if _, err := cs.JoinCommunity(as(aliceID), &com.JoinCommunityRequest{CommunityId: communityID}); err != nil {
+		t.Fatalf("JoinCommunity: %v", err)
+	}
+	_, err := cs.JoinCommunity(as(aliceID), &com.JoinCommunityRequest{CommunityId: communityID})
+	wantCode(t, err, codes.AlreadyExists)
+}
+
+func TestPrivateCommunityLists(t *testing.T) {
+	cs, st := newTestService(t)
+	communityID := newTestCommunity(t, cs, storage.VisibilityPrivate)
+	if _, err := st.Member().JoinCommunity(context.Background(), &storage.JoinCommunity{CommunityID: communityID, UserID: aliceID}); err != nil {
+		t.Fatalf("JoinCommunity: %v", err)
+	}
+	eventID := newTestEvent(t, cs, communityID, 0)
+
+	lists := []struct {
+		name string
+		list func(ctx context.Context) error
+	}{
+		{name: "members", list: func(ctx context.Context) error {
+			_, err := cs.ListCommunityMembers(ctx, &com.ListCommunityMembersRequest{CommunityId: communityID})
+			return err
+		}},
+		{name: "attendees", list: func(ctx context.Context) error {
+			_, err := cs.ListEventAttendees(ctx, &com.ListEventAttendeesRequest{EventId: eventID})
+			return err
+		}},
+		{name: "seed listings of the community", list: func(ctx context.Context) error {
+			_, err := cs.ListSeedListings(ctx, &com.ListSeedListingsRequest{CommunityId: communityID})
+			return err
+		}},
+		{name: "seed listings of an event", list: func(ctx context.Context) error {
+			_, err := cs.ListSeedListings(ctx, &com.ListSeedListingsRequest{EventId: eventID})
+			return err
+		}},
+		{name: "plantings", list: func(ctx context.Context) error {
+			_, err := cs.ListEventPlantings(ctx, &com.ListEventPlantingsRequest{EventId: eventID})
+			return err
+		}},
+	}
+	callers := []struct {
+		name string
+		ctx  context.Context
+		want codes.Code
+	}{
+		{name: "member", ctx: as(aliceID), want: codes.OK},
+		{name: "non-member", ctx: as(bobID), want: codes.NotFound},
+		{name: "anonymous", ctx: context.Background(), want: codes.NotFound},
+	}
+
+	for _, l := range lists {
+		for _, c := range callers {
+			t.Run(l.name+"/"+c.name, func(t *testing.T) {
+				wantCode(t, l.list(c.ctx), c.want)
+			})
+		}
+	}
+}
+
+func TestListMembersOfUnknownCommunity(t *testing.T) {
+	cs, _ := newTestService(t)
+	_, err := cs.ListCommunityMembers(as(aliceID), &com.ListCommunityMembersRequest{CommunityId: "00000000-0000-0000-0000-0000000000ff"})
+	wantCode(t, err, codes.NotFound)
+}
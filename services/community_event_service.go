@@ -1 +1,170 @@
 package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	com "github.com/Projects/ComunityService/genproto/CommunityService"
+	"github.com/Projects/ComunityService/storage"
+)
+
+// eventTypes mirrors the event_type enum in the events table.
+var eventTypes = map[string]bool{
+	"workshop":           true,
+	"seed_exchange":      true,
+	"community_planting": true,
+	"farmers_market":     true,
+}
+
+// ProtoToRepoEvent converts the proto event, rejecting start and end times that aren't RFC 3339.
+func ProtoToRepoEvent(protoEvent *com.Event) (*storage.Event, error) {
+	startTime, err := time.Parse(timeLayout, protoEvent.StartTime)
+	if err != nil {
+		return nil, &fieldError{field: "start_time", err: fmt.Errorf("invalid start_time %q: %v", protoEvent.StartTime, err)}
+	}
+	endTime, err := time.Parse(timeLayout, protoEvent.EndTime)
+	if err != nil {
+		return nil, &fieldError{field: "end_time", err: fmt.Errorf("invalid end_time %q: %v", protoEvent.EndTime, err)}
+	}
+
+	latitude, longitude := coordinates(protoEvent.Latitude, protoEvent.Longitude)
+	var capacity *int32
+	if protoEvent.Capacity != 0 {
+		capacity = &protoEvent.Capacity
+	}
+
+	return &storage.Event{
+		ID:             protoEvent.Id,
+		CommunityID:    protoEvent.CommunityId,
+		Name:           protoEvent.Name,
+		Description:    protoEvent.Description,
+		EventType:      protoEvent.EventType,
+		StartTime:      startTime,
+		EndTime:        endTime,
+		Location:       protoEvent.Location,
+		Latitude:       latitude,
+		Longitude:      longitude,
+		Capacity:       capacity,
+		RecurrenceRule: protoEvent.RecurrenceRule,
+		GardenID:       protoEvent.GardenId,
+		CreatedAt:      parseTime(protoEvent.CreatedAt),
+		UpdatedAt:      parseTime(protoEvent.UpdatedAt),
+	}, nil
+}
+
+func RepoToProtoEvent(repoEvent *storage.Event) *com.Event {
+	return &com.Event{
+		Id:             repoEvent.ID,
+		CommunityId:    repoEvent.CommunityID,
+		Name:           repoEvent.Name,
+		Description:    repoEvent.Description,
+		EventType:      repoEvent.EventType,
+		StartTime:      repoEvent.StartTime.Format(timeLayout),
+		EndTime:        repoEvent.EndTime.Format(timeLayout),
+		Location:       repoEvent.Location,
+		Latitude:       coordinate(repoEvent.Latitude),
+		Longitude:      coordinate(repoEvent.Longitude),
+		Capacity:       eventCapacity(repoEvent.Capacity),
+		RecurrenceRule: repoEvent.RecurrenceRule,
+		GardenId:       repoEvent.GardenID,
+		CreatedAt:      repoEvent.CreatedAt.Format(timeLayout),
+		UpdatedAt:      repoEvent.UpdatedAt.Format(timeLayout),
+	}
+}
+
+func eventCapacity(capacity *int32) int32 {
+	if capacity == nil {
+		return 0
+	}
+	return *capacity
+}
+
+func validateEvent(event *storage.Event) error {
+	if event.CommunityID == "" {
+		return &fieldError{field: "community_id", err: fmt.Errorf("community_id is required")}
+	}
+	if event.Name == "" {
+		return &fieldError{field: "name", err: fmt.Errorf("name is required")}
+	}
+	if !eventTypes[event.EventType] {
+		return &fieldError{field: "event_type", err: fmt.Errorf("invalid event_type %q", event.EventType)}
+	}
+	if !event.StartTime.Before(event.EndTime) {
+		return &fieldError{field: "start_time", err: fmt.Errorf("start_time must be before end_time")}
+	}
+	if event.GardenID != "" && event.EventType != "community_planting" {
+		return &fieldError{field: "garden_id", err: fmt.Errorf("garden_id is only allowed on community_planting events")}
+	}
+	if event.Capacity != nil && *event.Capacity < 0 {
+		return &fieldError{field: "capacity", err: fmt.Errorf("capacity must not be negative")}
+	}
+	if event.RecurrenceRule != "" {
+		if err := validateRecurrence(event); err != nil {
+			return err
+		}
+	}
+	if event.Latitude != nil {
+		return validateCoordinates(*event.Latitude, *event.Longitude)
+	}
+	return nil
+}
+
+func (cs *communityService) CreateCommunityEvent(ctx context.Context, eventReq *com.CreateCommunityEventRequest) (*com.CreateCommunityEventResponse, error) {
+	const action = "error creating community event"
+	userID, err := callerID(ctx, action, "")
+	if err != nil {
+		return nil, err
+	}
+	if eventReq.Event == nil {
+		return nil, invalidArgument(action, "event", "event is required")
+	}
+
+	event, err := ProtoToRepoEvent(eventReq.Event)
+	if err != nil {
+		return nil, fieldStatus(action, err)
+	}
+	if err := validateEvent(event); err != nil {
+		return nil, fieldStatus(action, err)
+	}
+	if err := authorize(ctx, cs.storage, action, permEventCreate, event.CommunityID, userID); err != nil {
+		return nil, err
+	}
+	if event.GardenID != "" {
+		if err := cs.validateEventGarden(ctx, action, event.GardenID); err != nil {
+			return nil, err
+		}
+	}
+
+	eventRes, err := cs.storage.Event().CreateCommunityEvent(ctx, event)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	return &com.CreateCommunityEventResponse{Event: RepoToProtoEvent(eventRes)}, nil
+}
+
+func (cs *communityService) GetCommunityEvent(ctx context.Context, eventReq *com.GetCommunityEventRequest) (*com.GetCommunityEventResponse, error) {
+	const action = "error getting community event"
+	eventRes, err := cs.storage.Event().GetCommunityEvent(ctx, eventReq.Id)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+	if err := checkEventVisible(ctx, cs.storage, action, eventRes); err != nil {
+		return nil, err
+	}
+
+	res := &com.GetCommunityEventResponse{Event: RepoToProtoEvent(eventRes)}
+	if eventRes.RecurrenceRule != "" {
+		from, to, err := occurrenceWindow(eventReq.From, eventReq.To)
+		if err != nil {
+			return nil, fieldStatus(action, err)
+		}
+		res.Occurrences, err = cs.eventOccurrences(ctx, eventRes, from, to, nil)
+		if err != nil {
+			return nil, toStatus(action, err)
+		}
+	}
+
+	return res, nil
+}
@@ -2,67 +2,291 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
+	"sync"
 	"time"
 
 	com "github.com/Projects/ComunityService/genproto/CommunityService"
 	user "github.com/Projects/ComunityService/genproto/UserManagementService"
-	"github.com/Projects/ComunityService/storage/postgres"
+	"github.com/Projects/ComunityService/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// maxConcurrentUserCalls bounds how many members are enriched from the user service at once.
+const maxConcurrentUserCalls = 10
+
 func (cs *communityService) JoinCommunity(ctx context.Context, comReq *com.JoinCommunityRequest) (*com.JoinCommunityResponse, error) {
-	jComRes := com.JoinCommunityResponse{}
-	userID := comReq.UserId
-	fmt.Println(comReq)
-	if userID == "" {
-		errMsg := "error: user ID is empty"
-		jComRes.Message = errMsg
-		return &jComRes, fmt.Errorf(errMsg)
+	const action = "error joining community"
+	userID, err := callerID(ctx, action, comReq.UserId)
+	if err != nil {
+		return nil, err
 	}
 
-	userIDReq := user.IdUserRequest{UserId: userID}
-	userRes, err := cs.userClient.GetUserById(ctx, &userIDReq)
+	community, err := cs.storage.Community().GetCommunity(ctx, comReq.CommunityId)
 	if err != nil {
-		errMsg := "Error: failed to get user details"
-		jComRes.Message = errMsg
-		return &jComRes, fmt.Errorf("%s: %v", errMsg, err)
+		return nil, toStatus(action, err)
+	}
+	if err := cs.checkNotBanned(ctx, action, community.ID, userID); err != nil {
+		return nil, err
 	}
 
-	jComRep := postgres.JoinCommunity{
-		CommunityID: comReq.CommunityId,
-		UserID:      userRes.UserId,
-		JoinedAt:    time.Now().Format(timeLayout),
+	userIDReq := user.IdUserRequest{UserId: userID}
+	userRes, err := cs.userClient.GetUserById(ctx, &userIDReq)
+	if err != nil {
+		return nil, userServiceError(action, err)
 	}
 
-	joinRes, msg := cs.CommunityRepository.JoinCommunity(ctx, &jComRep)
-	if msg.Error != nil {
-		errMsg := "Error: failed to join community"
-		jComRes.Message = errMsg
-		return &jComRes, fmt.Errorf("%s: %v", errMsg, *msg.Error)
+	var joinRes *storage.JoinCommunity
+	switch {
+	case comReq.InviteCode != "":
+		inviteID, err := verifyInviteCode(cs.inviteSecret, comReq.InviteCode, time.Now())
+		if err != nil {
+			return nil, invalidArgument(action, "invite_code", err.Error())
+		}
+		joinRes, err = cs.storage.Member().RedeemInvite(ctx, inviteID, community.ID, userRes.UserId)
+		if err != nil {
+			return nil, toStatus(action, err)
+		}
+	case community.Visibility == storage.VisibilityPrivate:
+		requestRes, err := cs.storage.Member().CreateJoinRequest(ctx, &storage.JoinRequest{CommunityID: community.ID, UserID: userRes.UserId})
+		if err != nil {
+			return nil, toStatus(action, err)
+		}
+		return &com.JoinCommunityResponse{
+			Message:     fmt.Sprintf("%s asked to join the community %s, the request awaits approval", userRes.Username, community.ID),
+			JoinRequest: RepoToProtoJoinRequest(requestRes),
+		}, nil
+	case community.Visibility == storage.VisibilityInviteOnly:
+		return nil, status.Errorf(codes.PermissionDenied, "%s: community %s can only be joined with an invite code", action, community.ID)
+	default:
+		jComRep := storage.JoinCommunity{
+			CommunityID: community.ID,
+			UserID:      userRes.UserId,
+			JoinedAt:    time.Now().Format(timeLayout),
+		}
+		joinRes, err = cs.storage.Member().JoinCommunity(ctx, &jComRep)
+		if err != nil {
+			return nil, toStatus(action, err)
+		}
 	}
 
-	jComRes.Message = fmt.Sprintf("%s successfully joined the community %s", userRes.Username, joinRes.CommunityID)
-	return &jComRes, nil
+	return &com.JoinCommunityResponse{Message: fmt.Sprintf("%s successfully joined the community %s", userRes.Username, joinRes.CommunityID)}, nil
 }
 
 func (cs *communityService) LeaveCommunity(ctx context.Context, c *com.LeaveCommunityRequest) (*com.LeaveCommunityResponse, error) {
-	userIDReq := user.IdUserRequest{UserId: c.UserId}
+	const action = "error leaving community"
+	userID, err := callerID(ctx, action, c.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := cs.storage.Member().GetMemberRole(ctx, c.CommunityId, userID)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return nil, toStatus(action, err)
+	}
+	if role == storage.RoleOwner {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s: the owner can't leave community %s, hand it over with TransferOwnership first", action, c.CommunityId)
+	}
+
+	userIDReq := user.IdUserRequest{UserId: userID}
 	userRes, err := cs.userClient.GetUserById(ctx, &userIDReq)
 	if err != nil {
-		errMsg := "Error getting user failed"
-		return &com.LeaveCommunityResponse{Message: errMsg}, fmt.Errorf("%s: %v", errMsg, err)
+		return nil, userServiceError(action, err)
 	}
 
-	jComRep := postgres.LeaveCommunity{
+	jComRep := storage.LeaveCommunity{
 		CommunityId: c.CommunityId,
 		UserID:      userRes.UserId,
 	}
 
-	msg := cs.CommunityRepository.LeaveCommunity(ctx, &jComRep)
-	if msg.Error != nil {
-		errMsg := "Error: failed to leave community for user"
-		return &com.LeaveCommunityResponse{Message: errMsg}, fmt.Errorf("%s: %v", errMsg, *msg.Error)
+	if err := cs.storage.Member().LeaveCommunity(ctx, &jComRep); err != nil {
+		return nil, toStatus(action, err)
 	}
 
 	return &com.LeaveCommunityResponse{Message: fmt.Sprintf("%s successfully left the community %s", userRes.Username, c.CommunityId)}, nil
 }
+
+func RepoToProtoCommunityMember(repoMember *storage.CommunityMember) *com.CommunityMember {
+	return &com.CommunityMember{
+		CommunityId: repoMember.CommunityID,
+		UserId:      repoMember.UserID,
+		JoinedAt:    repoMember.JoinedAt.Format(timeLayout),
+		Role:        repoMember.Role,
+	}
+}
+
+func (cs *communityService) PromoteModerator(ctx context.Context, roleReq *com.MemberRoleRequest) (*com.MemberRoleResponse, error) {
+	return cs.setMemberRole(ctx, roleReq, storage.RoleModerator)
+}
+
+func (cs *communityService) DemoteModerator(ctx context.Context, roleReq *com.MemberRoleRequest) (*com.MemberRoleResponse, error) {
+	return cs.setMemberRole(ctx, roleReq, storage.RoleMember)
+}
+
+func (cs *communityService) setMemberRole(ctx context.Context, roleReq *com.MemberRoleRequest, role string) (*com.MemberRoleResponse, error) {
+	const action = "error changing member role"
+	userID, err := callerID(ctx, action, roleReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if roleReq.MemberId == "" {
+		return nil, invalidArgument(action, "member_id", "member ID is empty")
+	}
+	if err := authorize(ctx, cs.storage, action, permMemberRoleChange, roleReq.CommunityId, userID); err != nil {
+		return nil, err
+	}
+
+	memberRes, err := cs.storage.Member().UpdateMemberRole(ctx, roleReq.CommunityId, roleReq.MemberId, role)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	return &com.MemberRoleResponse{Member: RepoToProtoCommunityMember(memberRes)}, nil
+}
+
+func (cs *communityService) ListCommunityMembers(ctx context.Context, listReq *com.ListCommunityMembersRequest) (*com.ListCommunityMembersResponse, error) {
+	const action = "error listing community members"
+	if listReq.CommunityId == "" {
+		return nil, invalidArgument(action, "community_id", "community ID is empty")
+	}
+	if _, err := visibleCommunity(ctx, cs.storage, listReq.CommunityId); err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	limit, offset := normalizePage(listReq.Limit, listReq.Offset)
+	filter := storage.MemberListFilter{
+		CommunityID: listReq.CommunityId,
+		Limit:       limit,
+		Offset:      offset,
+	}
+
+	membersRes, err := cs.storage.Member().ListCommunityMembers(ctx, &filter)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	members := make([]*com.CommunityMember, len(membersRes))
+	for i, member := range membersRes {
+		members[i] = RepoToProtoCommunityMember(member)
+	}
+	cs.enrichMembers(ctx, members)
+
+	return &com.ListCommunityMembersResponse{Members: members}, nil
+}
+
+// enrichMembers fills in user and profile details for each member, calling the user
+// service concurrently. A member whose lookup fails is returned without those details.
+func (cs *communityService) enrichMembers(ctx context.Context, members []*com.CommunityMember) {
+	sem := make(chan struct{}, maxConcurrentUserCalls)
+	var wg sync.WaitGroup
+
+	for _, member := range members {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(member *com.CommunityMember) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			cs.enrichMember(ctx, member)
+		}(member)
+	}
+
+	wg.Wait()
+}
+
+func (cs *communityService) enrichMember(ctx context.Context, member *com.CommunityMember) {
+	userIDReq := user.IdUserRequest{UserId: member.UserId}
+
+	var wg sync.WaitGroup
+	var userRes *user.UserResponse
+	var profileRes *user.UserProfileResponse
+	var userErr, profileErr error
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		userRes, userErr = cs.userClient.GetUserById(ctx, &userIDReq)
+	}()
+	go func() {
+		defer wg.Done()
+		profileRes, profileErr = cs.userClient.GetUserProfileById(ctx, &userIDReq)
+	}()
+	wg.Wait()
+
+	if userErr != nil {
+		log.Printf("failed to get user %s: %v", member.UserId, userErr)
+	} else {
+		member.Username = userRes.Username
+	}
+
+	if profileErr != nil {
+		log.Printf("failed to get profile of user %s: %v", member.UserId, profileErr)
+	} else if profile := profileRes.UserProfile; profile != nil {
+		member.FullName = profile.FullName
+		member.AvatarUrl = profile.AvatarUrl
+		member.Expertise = profile.Expertise
+	}
+}
+
+func (cs *communityService) ListUserCommunities(ctx context.Context, listReq *com.ListUserCommunitiesRequest) (*com.ListUserCommunitiesResponse, error) {
+	const action = "error listing user communities"
+	userID, err := callerID(ctx, action, listReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, offset := normalizePage(listReq.Limit, listReq.Offset)
+	filter := storage.UserCommunityFilter{
+		UserID: userID,
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	communitiesRes, err := cs.storage.Member().ListUserCommunities(ctx, &filter)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	var communities []*com.UserCommunity
+	for _, uc := range communitiesRes {
+		communities = append(communities, &com.UserCommunity{
+			Community: RepoToProtoCommunity(&uc.Community),
+			JoinedAt:  uc.JoinedAt.Format(timeLayout),
+			Role:      uc.Role,
+		})
+	}
+
+	return &com.ListUserCommunitiesResponse{Communities: communities}, nil
+}
+
+func (cs *communityService) TransferOwnership(ctx context.Context, transferReq *com.TransferOwnershipRequest) (*com.TransferOwnershipResponse, error) {
+	const action = "error transferring community ownership"
+	userID, err := callerID(ctx, action, transferReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if transferReq.CommunityId == "" {
+		return nil, invalidArgument(action, "community_id", "community ID is empty")
+	}
+	if transferReq.NewOwnerId == "" {
+		return nil, invalidArgument(action, "new_owner_id", "new owner ID is empty")
+	}
+	if transferReq.NewOwnerId == userID {
+		return nil, invalidArgument(action, "new_owner_id", "ownership can't be transferred to yourself")
+	}
+	if err := authorize(ctx, cs.storage, action, permOwnershipTransfer, transferReq.CommunityId, userID); err != nil {
+		return nil, err
+	}
+
+	ownerRes, previousRes, err := cs.storage.Member().TransferOwnership(ctx, transferReq.CommunityId, transferReq.NewOwnerId)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	return &com.TransferOwnershipResponse{
+		Owner:         RepoToProtoCommunityMember(ownerRes),
+		PreviousOwner: RepoToProtoCommunityMember(previousRes),
+	}, nil
+}
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	com "github.com/Projects/ComunityService/genproto/CommunityService"
+	"github.com/Projects/ComunityService/storage"
+	"google.golang.org/grpc/codes"
+)
+
+func TestJoinLeaveRejoin(t *testing.T) {
+	type step struct {
+		leave    bool
+		want     codes.Code
+		isMember bool
+	}
+	join := func(want codes.Code, isMember bool) step { return step{want: want, isMember: isMember} }
+	leave := func(want codes.Code, isMember bool) step { return step{leave: true, want: want, isMember: isMember} }
+
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{name: "join and leave", steps: []step{join(codes.OK, true), leave(codes.OK, false)}},
+		{name: "rejoin after leaving", steps: []step{join(codes.OK, true), leave(codes.OK, false), join(codes.OK, true)}},
+		{name: "join twice", steps: []step{join(codes.OK, true), join(codes.AlreadyExists, true)}},
+		{name: "leave without joining", steps: []step{leave(codes.NotFound, false)}},
+		{name: "leave twice", steps: []step{join(codes.OK, true), leave(codes.OK, false), leave(codes.NotFound, false)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs, st := newTestService(t)
+			communityID := newTestCommunity(t, cs, "")
+
+			for i, s := range tt.steps {
+				var err error
+				if s.leave {
+					_, err = cs.LeaveCommunity(as(aliceID), &com.LeaveCommunityRequest{CommunityId: communityID})
+				} else {
+					_, err = cs.JoinCommunity(as(aliceID), &com.JoinCommunityRequest{CommunityId: communityID})
+				}
+				wantCode(t, err, s.want)
+
+				isMember, err := st.Member().IsCommunityMember(context.Background(), communityID, aliceID)
+				if err != nil {
+					t.Fatalf("IsCommunityMember: %v", err)
+				}
+				if isMember != s.isMember {
+					t.Fatalf("step %d: member = %v, want %v", i, isMember, s.isMember)
+				}
+			}
+		})
+	}
+}
+
+func TestJoinUnknownCommunity(t *testing.T) {
+	cs, _ := newTestService(t)
+	_, err := cs.JoinCommunity(as(aliceID), &com.JoinCommunityRequest{CommunityId: "00000000-0000-0000-0000-0000000000ff"})
+	wantCode(t, err, codes.NotFound)
+}
+
+func TestOwnerLeave(t *testing.T) {
+	tests := []struct {
+		name     string
+		transfer bool
+		want     codes.Code
+		isMember bool
+	}{
+		{name: "owner can't leave", want: codes.FailedPrecondition, isMember: true},
+		{name: "former owner leaves after a transfer", transfer: true, want: codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs, st := newTestService(t)
+			communityID := newTestCommunity(t, cs, "", aliceID)
+			if tt.transfer {
+				_, err := cs.TransferOwnership(as(ownerID), &com.TransferOwnershipRequest{CommunityId: communityID, NewOwnerId: aliceID})
+				if err != nil {
+					t.Fatalf("TransferOwnership: %v", err)
+				}
+			}
+
+			_, err := cs.LeaveCommunity(as(ownerID), &com.LeaveCommunityRequest{CommunityId: communityID})
+			wantCode(t, err, tt.want)
+
+			isMember, err := st.Member().IsCommunityMember(context.Background(), communityID, ownerID)
+			if err != nil {
+				t.Fatalf("IsCommunityMember: %v", err)
+			}
+			if isMember != tt.isMember {
+				t.Errorf("member = %v, want %v", isMember, tt.isMember)
+			}
+		})
+	}
+}
+
+func TestStorageKeepsOwner(t *testing.T) {
+	cs, st := newTestService(t)
+	communityID := newTestCommunity(t, cs, "")
+
+	err := st.Member().LeaveCommunity(context.Background(), &storage.LeaveCommunity{CommunityId: communityID, UserID: ownerID})
+	if !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("LeaveCommunity of the owner = %v, want not found", err)
+	}
+}
@@ -0,0 +1,72 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	com "github.com/Projects/ComunityService/genproto/CommunityService"
+	"github.com/Projects/ComunityService/storage"
+)
+
+// maxNearbyRadiusKm bounds FindCommunitiesNearby so a single request can't scan the whole table.
+const maxNearbyRadiusKm = 500
+
+// coordinates converts proto coordinates to storage ones. Proto has no presence for
+// doubles, so (0, 0) means the location is unset.
+func coordinates(latitude, longitude float64) (*float64, *float64) {
+	if latitude == 0 && longitude == 0 {
+		return nil, nil
+	}
+	return &latitude, &longitude
+}
+
+func coordinate(v *float64) float64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+func validateCoordinates(latitude, longitude float64) error {
+	if latitude < -90 || latitude > 90 {
+		return &fieldError{field: "latitude", err: fmt.Errorf("latitude %v is out of range [-90, 90]", latitude)}
+	}
+	if longitude < -180 || longitude > 180 {
+		return &fieldError{field: "longitude", err: fmt.Errorf("longitude %v is out of range [-180, 180]", longitude)}
+	}
+	return nil
+}
+
+func (cs *communityService) FindCommunitiesNearby(ctx context.Context, nearbyReq *com.FindCommunitiesNearbyRequest) (*com.FindCommunitiesNearbyResponse, error) {
+	const action = "error finding nearby communities"
+	if err := validateCoordinates(nearbyReq.Latitude, nearbyReq.Longitude); err != nil {
+		return nil, fieldStatus(action, err)
+	}
+	if nearbyReq.RadiusKm <= 0 || nearbyReq.RadiusKm > maxNearbyRadiusKm {
+		return nil, invalidArgument(action, "radius_km", fmt.Sprintf("radius_km must be in (0, %d]", maxNearbyRadiusKm))
+	}
+
+	limit, _ := normalizePage(nearbyReq.Limit, 0)
+	filter := storage.NearbyFilter{
+		Latitude:  nearbyReq.Latitude,
+		Longitude: nearbyReq.Longitude,
+		RadiusKm:  nearbyReq.RadiusKm,
+		VisibleTo: visibleTo(ctx),
+		Limit:     limit,
+	}
+
+	nearbyRes, err := cs.storage.Community().FindCommunitiesNearby(ctx, &filter)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	var communities []*com.NearbyCommunity
+	for _, res := range nearbyRes {
+		communities = append(communities, &com.NearbyCommunity{
+			Community:  RepoToProtoCommunity(&res.Community),
+			DistanceKm: res.DistanceKm,
+		})
+	}
+
+	return &com.FindCommunitiesNearbyResponse{Communities: communities}, nil
+}
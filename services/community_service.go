@@ -3,118 +3,335 @@ package services
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/Projects/ComunityService/auth"
 	com "github.com/Projects/ComunityService/genproto/CommunityService"
+	garden "github.com/Projects/ComunityService/genproto/GardenManagementService"
 	user "github.com/Projects/ComunityService/genproto/UserManagementService"
-	"github.com/Projects/ComunityService/storage/postgres"
-	"github.com/jmoiron/sqlx"
+	"github.com/Projects/ComunityService/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const timeLayout = time.RFC3339
 
+const (
+	defaultPageSize = 50
+	maxPageSize     = 200
+)
+
+// communityRetention is how long a deleted community can still be restored.
+const communityRetention = 30 * 24 * time.Hour
+
 type communityService struct {
-	CommunityRepository *postgres.CommunityRepository
-	userClient          user.UserManagementServiceClient
+	storage      storage.IStorage
+	userClient   user.UserManagementServiceClient
+	gardenClient garden.GardenManagementServiceClient
+	inviteSecret []byte // signs invite codes
 	com.UnimplementedCommunityServiceServer
 }
 
-func NewCommunityService(db *sqlx.DB, userClient user.UserManagementServiceClient) *communityService {
+func NewCommunityService(st storage.IStorage, userClient user.UserManagementServiceClient, gardenClient garden.GardenManagementServiceClient, inviteSecret []byte) *communityService {
 	return &communityService{
-		CommunityRepository: postgres.NewCommunityRepository(db),
-		userClient:          userClient,
+		storage:      st,
+		userClient:   userClient,
+		gardenClient: gardenClient,
+		inviteSecret: inviteSecret,
 	}
 }
 
-func ProtoToRepoCommunity(protoCommunity *com.Community) *postgres.Community {
-	return &postgres.Community{
+func ProtoToRepoCommunity(protoCommunity *com.Community) *storage.Community {
+	latitude, longitude := coordinates(protoCommunity.Latitude, protoCommunity.Longitude)
+	return &storage.Community{
 		ID:          protoCommunity.Id,
 		Name:        protoCommunity.Name,
 		Description: protoCommunity.Description,
 		Location:    protoCommunity.Location,
+		Latitude:    latitude,
+		Longitude:   longitude,
+		Visibility:  protoCommunity.Visibility,
 		CreatedAt:   parseTime(protoCommunity.CreatedAt),
 		UpdatedAt:   parseTime(protoCommunity.UpdatedAt),
 	}
 }
 
-func RepoToProtoCommunity(repoCommunity *postgres.Community) *com.Community {
+func RepoToProtoCommunity(repoCommunity *storage.Community) *com.Community {
 	return &com.Community{
 		Id:          repoCommunity.ID,
 		Name:        repoCommunity.Name,
 		Description: repoCommunity.Description,
 		Location:    repoCommunity.Location,
+		Latitude:    coordinate(repoCommunity.Latitude),
+		Longitude:   coordinate(repoCommunity.Longitude),
+		Visibility:  repoCommunity.Visibility,
 		CreatedAt:   repoCommunity.CreatedAt.Format(timeLayout),
 		UpdatedAt:   repoCommunity.UpdatedAt.Format(timeLayout),
 	}
 }
 
+// visibilities mirrors the community_visibility enum in the communities table.
+var visibilities = map[string]bool{
+	storage.VisibilityPublic:     true,
+	storage.VisibilityPrivate:    true,
+	storage.VisibilityInviteOnly: true,
+}
+
 func parseTime(timeStr string) time.Time {
 	t, _ := time.Parse(timeLayout, timeStr)
 	return t
 }
 
+// normalizePage applies the default and maximum page size to list requests.
+func normalizePage(limit, offset int32) (int32, int32) {
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
+
 func (cs *communityService) CreateCommunity(ctx context.Context, comReq *com.CreateCommunityRequest) (*com.CreateCommunityResponse, error) {
+	const action = "error creating community"
+	userID, err := callerID(ctx, action, comReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if comReq.Community == nil {
+		return nil, invalidArgument(action, "community", "community is required")
+	}
+	if err := validateCoordinates(comReq.Community.Latitude, comReq.Community.Longitude); err != nil {
+		return nil, fieldStatus(action, err)
+	}
+	if comReq.Community.Visibility == "" {
+		comReq.Community.Visibility = storage.VisibilityPublic
+	}
+	if !visibilities[comReq.Community.Visibility] {
+		return nil, invalidArgument(action, "visibility", fmt.Sprintf("invalid visibility %q", comReq.Community.Visibility))
+	}
+
+	userRes, err := cs.userClient.GetUserById(ctx, &user.IdUserRequest{UserId: userID})
+	if err != nil {
+		return nil, userServiceError(action, err)
+	}
+
 	community := ProtoToRepoCommunity(comReq.Community)
-	communityRes, msg := cs.CommunityRepository.CreateCommunity(ctx, community)
-	if msg.Error != nil {
-		return nil, fmt.Errorf("error creating community: %v", *msg.Error)
+	communityRes, err := cs.storage.Community().CreateCommunity(ctx, community, userRes.UserId)
+	if err != nil {
+		return nil, toStatus(action, err)
 	}
 	return &com.CreateCommunityResponse{Community: RepoToProtoCommunity(communityRes)}, nil
 }
 
 func (cs *communityService) GetCommunityBy(ctx context.Context, comReq *com.GetCommunityRequest) (*com.GetCommunityResponse, error) {
-	communityRes, msg := cs.CommunityRepository.GetCommunity(ctx, comReq.Id)
-	if msg.Error != nil {
-		return nil, fmt.Errorf("error getting community: %v", *msg.Error)
+	communityRes, err := visibleCommunity(ctx, cs.storage, comReq.Id)
+	if err != nil {
+		return nil, toStatus("error getting community", err)
 	}
 
 	return &com.GetCommunityResponse{Community: RepoToProtoCommunity(communityRes)}, nil
 }
 
 func (cs *communityService) GetAllCommunity(ctx context.Context, comReq *com.GetAllCommunityRequest) (*com.GetAllCommunityResponse, error) {
-	filter := postgres.CommunityGetFilter{
-		Name:   &comReq.Name,
-		Limit:  &comReq.Limit,
-		Offset: &comReq.Offset,
+	const action = "error getting communities"
+
+	// Fetch one extra row to learn whether another page follows.
+	limit, _ := normalizePage(comReq.Limit, 0)
+	fetch := limit + 1
+	filter := storage.CommunityGetFilter{
+		Limit: &fetch,
+	}
+	if comReq.Name != "" {
+		filter.Name = &comReq.Name
+	}
+	// Private communities are only listed to their members and to admins.
+	filter.VisibleTo = visibleTo(ctx)
+
+	if comReq.PageToken != "" {
+		after, err := decodeCommunityPageToken(comReq.PageToken)
+		if err != nil {
+			return nil, invalidArgument(action, "page_token", err.Error())
+		}
+		filter.After = after
+	} else if comReq.Offset > 0 {
+		offset := comReq.Offset
+		filter.Offset = &offset
+	}
+
+	communityRes, err := cs.storage.Community().GetAllCommunities(ctx, &filter)
+	if err != nil {
+		return nil, toStatus(action, err)
 	}
 
-	communityRes, msg := cs.CommunityRepository.GetAllCommunities(ctx, &filter)
-	if msg.Error != nil {
-		return nil, fmt.Errorf("error getting communities: %v", *msg.Error)
+	res := &com.GetAllCommunityResponse{}
+	if len(communityRes) > int(limit) {
+		communityRes = communityRes[:limit]
+		res.NextPageToken = encodeCommunityPageToken(communityRes[len(communityRes)-1])
 	}
 
-	fmt.Println(communityRes)
-	var communities []*com.Community
 	for _, community := range communityRes {
-		communities = append(communities, RepoToProtoCommunity(community))
+		res.Communities = append(res.Communities, RepoToProtoCommunity(community))
+	}
+
+	if comReq.IncludeTotal {
+		total, err := cs.storage.Community().CountCommunities(ctx, &filter)
+		if err != nil {
+			return nil, toStatus(action, err)
+		}
+		res.TotalCount = total
 	}
 
-	return &com.GetAllCommunityResponse{Communities: communities}, nil
+	return res, nil
 }
 
 func (cs *communityService) UpdateCommunity(ctx context.Context, upCom *com.UpdateCommunityRequest) (*com.UpdateCommunityResponse, error) {
+	const action = "error updating community"
+	userID, err := callerID(ctx, action, upCom.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if upCom.Community == nil {
+		return nil, invalidArgument(action, "community", "community is required")
+	}
+	if err := validateCoordinates(upCom.Community.Latitude, upCom.Community.Longitude); err != nil {
+		return nil, fieldStatus(action, err)
+	}
+	if upCom.Community.Visibility != "" && !visibilities[upCom.Community.Visibility] {
+		return nil, invalidArgument(action, "visibility", fmt.Sprintf("invalid visibility %q", upCom.Community.Visibility))
+	}
+	if err := authorize(ctx, cs.storage, action, permCommunityUpdate, upCom.Community.Id, userID); err != nil {
+		return nil, err
+	}
+
 	community := ProtoToRepoCommunity(upCom.Community)
-	upFilter := postgres.CommunityUpdateFilter{
+	upFilter := storage.CommunityUpdateFilter{
 		ID:          &upCom.Community.Id,
 		Name:        &community.Name,
 		Description: &community.Description,
 		Location:    &community.Location,
+		Latitude:    community.Latitude,
+		Longitude:   community.Longitude,
+	}
+	if community.Visibility != "" {
+		upFilter.Visibility = &community.Visibility
 	}
-	communityRes, msg := cs.CommunityRepository.UpdateCommunity(ctx, &upFilter)
-	if msg.Error != nil {
-		return nil, fmt.Errorf("error updating community: %v", *msg.Error)
+	communityRes, err := cs.storage.Community().UpdateCommunity(ctx, &upFilter)
+	if err != nil {
+		return nil, toStatus(action, err)
 	}
 	return &com.UpdateCommunityResponse{Community: RepoToProtoCommunity(communityRes)}, nil
 }
 
 func (cs *communityService) DeleteCommunity(ctx context.Context, comReq *com.DeleteCommunityRequest) (*com.DeleteCommunityResponse, error) {
-	msg := cs.CommunityRepository.DeleteCommunity(ctx, comReq.Id)
-	if msg.Error != nil {
-		return nil, fmt.Errorf("error deleting community: %v", *msg.Error)
+	const action = "error deleting community"
+	userID, err := callerID(ctx, action, comReq.UserId)
+	if err != nil {
+		return nil, err
 	}
-	return &com.DeleteCommunityResponse{Message: *msg.Message}, nil
+	if comReq.Id == "" {
+		return nil, invalidArgument(action, "id", "community ID is empty")
+	}
+	if _, err := cs.storage.Community().GetCommunity(ctx, comReq.Id); err != nil {
+		return nil, toStatus(action, err)
+	}
+	if err := authorize(ctx, cs.storage, action, permCommunityDelete, comReq.Id, userID); err != nil {
+		return nil, err
+	}
+
+	if err := cs.storage.Community().DeleteCommunity(ctx, comReq.Id, userID); err != nil {
+		return nil, toStatus(action, err)
+	}
+	return &com.DeleteCommunityResponse{Message: "Community deleted successfully"}, nil
+}
+
+// RestoreCommunity undoes a deletion made less than communityRetention ago. Memberships
+// are closed by the deletion, so the previous owner is checked against the snapshot taken
+// of it rather than the member list.
+func (cs *communityService) RestoreCommunity(ctx context.Context, comReq *com.RestoreCommunityRequest) (*com.RestoreCommunityResponse, error) {
+	const action = "error restoring community"
+	userID, err := callerID(ctx, action, comReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if comReq.Id == "" {
+		return nil, invalidArgument(action, "id", "community ID is empty")
+	}
+
+	deleted, err := cs.storage.Community().GetDeletedCommunity(ctx, comReq.Id)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	sub := subject{userID: userID}
+	if id, ok := auth.FromContext(ctx); ok {
+		sub.admin = id.IsAdmin()
+	}
+	if deleted.OwnerID == userID {
+		sub.role = storage.RoleOwner
+	}
+	if err := enforce(ctx, cs.storage, action, permCommunityRestore, comReq.Id, sub); err != nil {
+		return nil, err
+	}
+	if time.Since(deleted.DeletedAt) > communityRetention {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s: community %s was deleted more than %d days ago", action, comReq.Id, int(communityRetention.Hours()/24))
+	}
+
+	communityRes, err := cs.storage.Community().RestoreCommunity(ctx, comReq.Id, userID)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+	return &com.RestoreCommunityResponse{Community: RepoToProtoCommunity(communityRes)}, nil
+}
+
+func (cs *communityService) IsUserValid(ctx context.Context, comReq *com.IsCommunityValidRequest) (*com.IsCommunityValidResponse, error) {
+	const action = "error checking community"
+	if comReq.Id == "" {
+		return nil, invalidArgument(action, "id", "community ID is empty")
+	}
+
+	valid, err := cs.storage.Community().IsValidCommunity(ctx, comReq.Id)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	return &com.IsCommunityValidResponse{Valid: valid}, nil
 }
 
-func (cs *communityService) IsValidCommunity(ctx context.Context, comReq *com.IsCommunityValidRequest) (*com.IsCommunityValidResponse, error) {
-	return cs.CommunityRepository.IsValidCommunity(ctx, comReq)
+func (cs *communityService) SearchCommunities(ctx context.Context, searchReq *com.SearchCommunitiesRequest) (*com.SearchCommunitiesResponse, error) {
+	const action = "error searching communities"
+	query := strings.TrimSpace(searchReq.Query)
+	if query == "" {
+		return nil, invalidArgument(action, "query", "query is empty")
+	}
+
+	limit, offset := normalizePage(searchReq.Limit, searchReq.Offset)
+	filter := storage.CommunitySearchFilter{
+		Query:     query,
+		VisibleTo: visibleTo(ctx),
+		Limit:     limit,
+		Offset:    offset,
+	}
+
+	resultsRes, err := cs.storage.Community().SearchCommunities(ctx, &filter)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	var results []*com.CommunitySearchResult
+	for _, res := range resultsRes {
+		results = append(results, &com.CommunitySearchResult{
+			Community:          RepoToProtoCommunity(&res.Community),
+			Rank:               res.Rank,
+			NameHighlight:      res.NameHighlight,
+			DescriptionSnippet: res.Snippet,
+		})
+	}
+
+	return &com.SearchCommunitiesResponse{Results: results}, nil
 }
@@ -0,0 +1,46 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	com "github.com/Projects/ComunityService/genproto/CommunityService"
+)
+
+func TestDeletedCommunityOwner(t *testing.T) {
+	tests := []struct {
+		name      string
+		newOwner  string
+		wantOwner string
+	}{
+		{name: "original owner", wantOwner: ownerID},
+		{name: "owner after a transfer", newOwner: aliceID, wantOwner: aliceID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs, st := newTestService(t)
+			communityID := newTestCommunity(t, cs, "", aliceID)
+			deleter := ownerID
+			if tt.newOwner != "" {
+				_, err := cs.TransferOwnership(as(ownerID), &com.TransferOwnershipRequest{CommunityId: communityID, NewOwnerId: tt.newOwner})
+				if err != nil {
+					t.Fatalf("TransferOwnership: %v", err)
+				}
+				deleter = tt.newOwner
+			}
+
+			if _, err := cs.DeleteCommunity(as(deleter), &com.DeleteCommunityRequest{Id: communityID}); err != nil {
+				t.Fatalf("DeleteCommunity: %v", err)
+			}
+
+			deleted, err := st.Community().GetDeletedCommunity(context.Background(), communityID)
+			if err != nil {
+				t.Fatalf("GetDeletedCommunity: %v", err)
+			}
+			if deleted.OwnerID != tt.wantOwner {
+				t.Errorf("owner = %q, want %q", deleted.OwnerID, tt.wantOwner)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Projects/ComunityService/storage"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// toStatus translates a repository error into a gRPC status so clients can branch on
+// the code and details instead of the message text.
+func toStatus(action string, err error) error {
+	msg := fmt.Sprintf("%s: %v", action, err)
+
+	var repoErr *storage.Error
+	if !errors.As(err, &repoErr) {
+		if _, ok := status.FromError(err); ok {
+			return status.Error(status.Code(err), msg)
+		}
+		return status.Error(codes.Internal, msg)
+	}
+
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return withDetails(codes.NotFound, msg, &errdetails.ResourceInfo{
+			ResourceType: repoErr.Resource,
+			Description:  repoErr.Error(),
+		})
+	case errors.Is(err, storage.ErrConflict):
+		return withDetails(codes.AlreadyExists, msg, &errdetails.ResourceInfo{
+			ResourceType: repoErr.Resource,
+			Description:  repoErr.Error(),
+		})
+	case errors.Is(err, storage.ErrInvalidInput):
+		return withDetails(codes.InvalidArgument, msg, &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: repoErr.Field, Description: repoErr.Error()},
+			},
+		})
+	case errors.Is(err, storage.ErrForeignKey):
+		return withDetails(codes.FailedPrecondition, msg, &errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{
+				{Type: "FOREIGN_KEY", Subject: repoErr.Field, Description: repoErr.Error()},
+			},
+		})
+	}
+
+	return status.Error(codes.Internal, msg)
+}
+
+// invalidArgument reports a request field that failed validation.
+func invalidArgument(action, field, description string) error {
+	return withDetails(codes.InvalidArgument, fmt.Sprintf("%s: %s", action, description), &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: field, Description: description},
+		},
+	})
+}
+
+// fieldError marks a validation failure of a single request field.
+type fieldError struct {
+	field string
+	err   error
+}
+
+func (e *fieldError) Error() string { return e.err.Error() }
+
+// fieldStatus converts a *fieldError into an InvalidArgument status naming the field.
+func fieldStatus(action string, err error) error {
+	var fe *fieldError
+	if errors.As(err, &fe) {
+		return invalidArgument(action, fe.field, fe.Error())
+	}
+	return status.Error(codes.InvalidArgument, fmt.Sprintf("%s: %v", action, err))
+}
+
+// userServiceError keeps the status code returned by the user management service.
+func userServiceError(action string, err error) error {
+	return status.Errorf(status.Code(err), "%s: failed to get user details: %v", action, status.Convert(err).Message())
+}
+
+func gardenServiceError(action string, err error) error {
+	return status.Errorf(status.Code(err), "%s: failed to get garden details: %v", action, status.Convert(err).Message())
+}
+
+func withDetails(code codes.Code, msg string, details ...protoadapt.MessageV1) error {
+	st, err := status.New(code, msg).WithDetails(details...)
+	if err != nil {
+		return status.Error(code, msg)
+	}
+	return st.Err()
+}
@@ -0,0 +1,223 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	com "github.com/Projects/ComunityService/genproto/CommunityService"
+	"github.com/Projects/ComunityService/storage"
+)
+
+const (
+	eventStatusUpcoming = "upcoming"
+	eventStatusOngoing  = "ongoing"
+	eventStatusPast     = "past"
+)
+
+// parseOptionalTime parses an RFC 3339 request field, returning nil when it is empty.
+func parseOptionalTime(field, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(timeLayout, value)
+	if err != nil {
+		return nil, &fieldError{field: field, err: fmt.Errorf("invalid %s %q: %v", field, value, err)}
+	}
+	return &t, nil
+}
+
+func validateEventTypes(types []string) error {
+	for _, t := range types {
+		if !eventTypes[t] {
+			return &fieldError{field: "types", err: fmt.Errorf("invalid event_type %q", t)}
+		}
+	}
+	return nil
+}
+
+// occurrenceMatches applies the time conditions of an event listing to one occurrence.
+func occurrenceMatches(filter *storage.EventListFilter, occ occurrence) bool {
+	if filter.From != nil && occ.End.Before(*filter.From) {
+		return false
+	}
+	if filter.To != nil && occ.Start.After(*filter.To) {
+		return false
+	}
+	if filter.StartsAfter != nil && occ.Start.Before(*filter.StartsAfter) {
+		return false
+	}
+	if filter.EndsBefore != nil && !occ.End.Before(*filter.EndsBefore) {
+		return false
+	}
+	return true
+}
+
+// occurrenceRange picks the window to expand a recurring event in for a listing. Open
+// ends default to defaultOccurrenceWindow around the bound that is set, or from now.
+func occurrenceRange(filter *storage.EventListFilter, duration time.Duration, now time.Time) (time.Time, time.Time) {
+	var lo, hi time.Time
+	if filter.From != nil {
+		lo = filter.From.Add(-duration)
+	}
+	if filter.StartsAfter != nil && (lo.IsZero() || filter.StartsAfter.After(lo)) {
+		lo = *filter.StartsAfter
+	}
+	if filter.To != nil {
+		hi = *filter.To
+	}
+	if filter.EndsBefore != nil && (hi.IsZero() || filter.EndsBefore.Before(hi)) {
+		hi = *filter.EndsBefore
+	}
+
+	switch {
+	case lo.IsZero() && hi.IsZero():
+		lo, hi = now, now.Add(defaultOccurrenceWindow)
+	case lo.IsZero():
+		lo = hi.Add(-defaultOccurrenceWindow - duration)
+	case hi.IsZero():
+		hi = lo.Add(defaultOccurrenceWindow)
+	}
+	return lo, hi
+}
+
+// maxEventBatches bounds how many storage pages one listing reads while skipping
+// recurring events that have no occurrence in the window.
+const maxEventBatches = 5
+
+// listEvents runs an event listing page. Recurring events are kept only when one of their
+// occurrences matches the filter, and those occurrences are returned alongside them.
+// Storage is read until the page is full, so skipped events don't shorten it; the page
+// token is only set once another matching event is seen, or when maxEventBatches is hit.
+func (cs *communityService) listEvents(ctx context.Context, action string, filter *storage.EventListFilter, limit int32, pageToken string) (*com.ListCommunityEventsResponse, error) {
+	// Fetch one extra row to learn whether storage has more.
+	limit, _ = normalizePage(limit, 0)
+	filter.Limit = limit + 1
+
+	if pageToken != "" {
+		after, err := decodeEventPageToken(pageToken)
+		if err != nil {
+			return nil, invalidArgument(action, "page_token", err.Error())
+		}
+		filter.After = after
+	}
+
+	res := &com.ListCommunityEventsResponse{}
+	now := time.Now()
+	keep := func(occ occurrence) bool { return occurrenceMatches(filter, occ) }
+	var lastKept *storage.Event
+	for batch := 1; ; batch++ {
+		eventsRes, err := cs.storage.Event().ListEvents(ctx, filter)
+		if err != nil {
+			return nil, toStatus(action, err)
+		}
+
+		for _, event := range eventsRes {
+			var occurrences []*com.EventOccurrence
+			if event.RecurrenceRule != "" {
+				from, to := occurrenceRange(filter, event.EndTime.Sub(event.StartTime), now)
+				occurrences, err = cs.eventOccurrences(ctx, event, from, to, keep)
+				if err != nil {
+					return nil, toStatus(action, err)
+				}
+				if len(occurrences) == 0 {
+					continue
+				}
+			}
+			if len(res.Events) == int(limit) {
+				res.NextPageToken = encodeEventPageToken(lastKept)
+				return res, nil
+			}
+			res.Occurrences = append(res.Occurrences, occurrences...)
+			res.Events = append(res.Events, RepoToProtoEvent(event))
+			lastKept = event
+		}
+
+		if len(eventsRes) < int(filter.Limit) {
+			return res, nil
+		}
+		last := eventsRes[len(eventsRes)-1]
+		if batch == maxEventBatches {
+			// The next page resumes after the last event read, matching or not.
+			res.NextPageToken = encodeEventPageToken(last)
+			return res, nil
+		}
+		filter.After = &storage.EventCursor{StartTime: last.StartTime, ID: last.ID}
+	}
+}
+
+func (cs *communityService) ListCommunityEvents(ctx context.Context, listReq *com.ListCommunityEventsRequest) (*com.ListCommunityEventsResponse, error) {
+	const action = "error listing community events"
+	if listReq.CommunityId == "" {
+		return nil, invalidArgument(action, "community_id", "community ID is empty")
+	}
+	if err := validateEventTypes(listReq.Types); err != nil {
+		return nil, fieldStatus(action, err)
+	}
+	if _, err := visibleCommunity(ctx, cs.storage, listReq.CommunityId); err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	from, err := parseOptionalTime("from", listReq.From)
+	if err != nil {
+		return nil, fieldStatus(action, err)
+	}
+	to, err := parseOptionalTime("to", listReq.To)
+	if err != nil {
+		return nil, fieldStatus(action, err)
+	}
+	if from != nil && to != nil && to.Before(*from) {
+		return nil, invalidArgument(action, "to", "to must not be before from")
+	}
+
+	filter := storage.EventListFilter{
+		CommunityID: listReq.CommunityId,
+		Types:       listReq.Types,
+		From:        from,
+		To:          to,
+	}
+
+	now := time.Now()
+	switch listReq.Status {
+	case "":
+	case eventStatusUpcoming:
+		filter.StartsAfter = &now
+	case eventStatusOngoing:
+		if (from != nil && from.After(now)) || (to != nil && to.Before(now)) {
+			return &com.ListCommunityEventsResponse{}, nil
+		}
+		filter.From, filter.To = &now, &now
+	case eventStatusPast:
+		filter.EndsBefore = &now
+	default:
+		return nil, invalidArgument(action, "status", fmt.Sprintf("invalid status %q, expected upcoming, ongoing or past", listReq.Status))
+	}
+
+	return cs.listEvents(ctx, action, &filter, listReq.Limit, listReq.PageToken)
+}
+
+func (cs *communityService) ListUpcomingEventsForUser(ctx context.Context, listReq *com.ListUpcomingEventsForUserRequest) (*com.ListCommunityEventsResponse, error) {
+	const action = "error listing upcoming events"
+	userID, err := callerID(ctx, action, listReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateEventTypes(listReq.Types); err != nil {
+		return nil, fieldStatus(action, err)
+	}
+
+	to, err := parseOptionalTime("to", listReq.To)
+	if err != nil {
+		return nil, fieldStatus(action, err)
+	}
+
+	now := time.Now()
+	filter := storage.EventListFilter{
+		MemberID:    userID,
+		Types:       listReq.Types,
+		To:          to,
+		StartsAfter: &now,
+	}
+
+	return cs.listEvents(ctx, action, &filter, listReq.Limit, listReq.PageToken)
+}
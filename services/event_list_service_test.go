@@ -0,0 +1,84 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	com "github.com/Projects/ComunityService/genproto/CommunityService"
+)
+
+// createEvent adds a one hour workshop starting at start, recurring when rule is set.
+func createEvent(t *testing.T, cs *communityService, communityID string, start time.Time, rule string) string {
+	t.Helper()
+	res, err := cs.CreateCommunityEvent(as(ownerID), &com.CreateCommunityEventRequest{Event: &com.Event{
+		CommunityId:    communityID,
+		Name:           "Workshop",
+		EventType:      "workshop",
+		StartTime:      start.Format(timeLayout),
+		EndTime:        start.Add(time.Hour).Format(timeLayout),
+		RecurrenceRule: rule,
+	}})
+	if err != nil {
+		t.Fatalf("CreateCommunityEvent: %v", err)
+	}
+	return res.Event.Id
+}
+
+func TestListEventsPagesSkipRecurring(t *testing.T) {
+	tests := []struct {
+		name      string
+		recurring int // series that end before the window but start before its one-off events
+		oneOff    int
+		limit     int32
+		wantPages []int
+	}{
+		{name: "no skipped series", oneOff: 3, limit: 2, wantPages: []int{2, 1}},
+		{name: "skipped series fill the first batch", recurring: 6, oneOff: 3, limit: 2, wantPages: []int{2, 1}},
+		{name: "exact page has no token", recurring: 4, oneOff: 2, limit: 2, wantPages: []int{2}},
+		{name: "only skipped series", recurring: 5, limit: 2, wantPages: []int{0}},
+		// Five batches of two rows are read before the page gives up and hands out a token.
+		{name: "batch limit", recurring: 12, oneOff: 1, limit: 1, wantPages: []int{0, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs, _ := newTestService(t)
+			communityID := newTestCommunity(t, cs, "")
+			base := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+			for i := 0; i < tt.recurring; i++ {
+				createEvent(t, cs, communityID, base.Add(time.Duration(i)*time.Minute), "FREQ=DAILY;COUNT=2")
+			}
+			for i := 0; i < tt.oneOff; i++ {
+				createEvent(t, cs, communityID, base.AddDate(0, 0, 10+i), "")
+			}
+
+			req := &com.ListCommunityEventsRequest{
+				CommunityId: communityID,
+				From:        base.AddDate(0, 0, 5).Format(timeLayout),
+				To:          base.AddDate(0, 0, 30).Format(timeLayout),
+				Limit:       tt.limit,
+			}
+			var pages []int
+			for {
+				res, err := cs.ListCommunityEvents(as(ownerID), req)
+				if err != nil {
+					t.Fatalf("ListCommunityEvents: %v", err)
+				}
+				pages = append(pages, len(res.Events))
+				if res.NextPageToken == "" || len(pages) > 10 {
+					break
+				}
+				req.PageToken = res.NextPageToken
+			}
+
+			if len(pages) != len(tt.wantPages) {
+				t.Fatalf("pages = %v, want %v", pages, tt.wantPages)
+			}
+			for i := range pages {
+				if pages[i] != tt.wantPages[i] {
+					t.Fatalf("pages = %v, want %v", pages, tt.wantPages)
+				}
+			}
+		})
+	}
+}
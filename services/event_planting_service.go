@@ -0,0 +1,159 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	com "github.com/Projects/ComunityService/genproto/CommunityService"
+	garden "github.com/Projects/ComunityService/genproto/GardenManagementService"
+	"github.com/Projects/ComunityService/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// plantedStatus is the Garden Management Service status given to plants recorded at an event.
+const plantedStatus = "planted"
+
+func RepoToProtoEventPlanting(repoPlanting *storage.EventPlanting) *com.EventPlanting {
+	return &com.EventPlanting{
+		Id:         repoPlanting.ID,
+		EventId:    repoPlanting.EventID,
+		GardenId:   repoPlanting.GardenID,
+		PlantId:    repoPlanting.PlantID,
+		Species:    repoPlanting.Species,
+		Quantity:   repoPlanting.Quantity,
+		RecordedBy: repoPlanting.RecordedBy,
+		CreatedAt:  repoPlanting.CreatedAt.Format(timeLayout),
+	}
+}
+
+// withRecordedPlantings attaches the plantings recorded before a failure to its status.
+func withRecordedPlantings(err error, res *com.RecordEventPlantingsResponse) error {
+	if len(res.Plantings) == 0 {
+		return err
+	}
+	st, detailsErr := status.Convert(err).WithDetails(protoadapt.MessageV1Of(res))
+	if detailsErr != nil {
+		return err
+	}
+	return st.Err()
+}
+
+// validateEventGarden checks that the garden exists in the Garden Management Service.
+func (cs *communityService) validateEventGarden(ctx context.Context, action, gardenID string) error {
+	if _, err := cs.gardenClient.GetGardenByID(ctx, &garden.IdRequest{Id: gardenID}); err != nil {
+		return gardenServiceError(action, err)
+	}
+	return nil
+}
+
+func (cs *communityService) RecordEventPlantings(ctx context.Context, recordReq *com.RecordEventPlantingsRequest) (*com.RecordEventPlantingsResponse, error) {
+	const action = "error recording event plantings"
+	userID, err := callerID(ctx, action, recordReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if recordReq.EventId == "" {
+		return nil, invalidArgument(action, "event_id", "event ID is empty")
+	}
+	if len(recordReq.Plantings) == 0 {
+		return nil, invalidArgument(action, "plantings", "at least one planting is required")
+	}
+	for i, planting := range recordReq.Plantings {
+		if strings.TrimSpace(planting.Species) == "" {
+			return nil, invalidArgument(action, fmt.Sprintf("plantings[%d].species", i), "species is empty")
+		}
+		if planting.Quantity <= 0 {
+			return nil, invalidArgument(action, fmt.Sprintf("plantings[%d].quantity", i), "quantity must be positive")
+		}
+	}
+
+	event, err := cs.storage.Event().GetCommunityEvent(ctx, recordReq.EventId)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+	if err := authorize(ctx, cs.storage, action, permEventPlantingRecord, event.CommunityID, userID); err != nil {
+		return nil, err
+	}
+	if event.GardenID == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s: event %s is not linked to a garden", action, event.ID)
+	}
+	// A recurring event only needs one occurrence to have taken place.
+	now := time.Now()
+	if (event.RecurrenceRule == "" && event.EndTime.After(now)) || event.StartTime.After(now) {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s: event %s has not taken place yet", action, event.ID)
+	}
+
+	// Each planting is recorded as pending before its plant is created, so a plant never
+	// exists without a record. Whatever fails part way through, the plantings recorded so
+	// far are returned in the error details and a client can retry just the rest.
+	res := &com.RecordEventPlantingsResponse{}
+	for _, planting := range recordReq.Plantings {
+		species := strings.TrimSpace(planting.Species)
+		pending, err := cs.storage.Event().RecordEventPlanting(ctx, &storage.EventPlanting{
+			EventID:    event.ID,
+			GardenID:   event.GardenID,
+			Species:    species,
+			Quantity:   planting.Quantity,
+			RecordedBy: userID,
+		})
+		if err != nil {
+			return nil, withRecordedPlantings(toStatus(action, err), res)
+		}
+
+		plantRes, err := cs.gardenClient.CreatePlantByGardenID(ctx, &garden.PlantRequest{
+			GardenId: event.GardenID,
+			Species:  species,
+			Quantity: int64(planting.Quantity),
+			Status:   plantedStatus,
+		})
+		if err != nil {
+			if err := cs.storage.Event().DeletePendingEventPlanting(ctx, pending.ID); err != nil {
+				log.Printf("failed to delete pending event planting %s: %v", pending.ID, err)
+			}
+			return nil, withRecordedPlantings(status.Errorf(status.Code(err), "%s: failed to create %s in garden %s after recording %d plantings: %v",
+				action, species, event.GardenID, len(res.Plantings), status.Convert(err).Message()), res)
+		}
+
+		plantingRes, err := cs.storage.Event().CompleteEventPlanting(ctx, pending.ID, plantRes.Id)
+		if err != nil {
+			// The planting stays pending; the log is what links it to its plant.
+			log.Printf("plant %s was created in garden %s for pending event planting %s: %v", plantRes.Id, event.GardenID, pending.ID, err)
+			return nil, withRecordedPlantings(toStatus(action, err), res)
+		}
+		res.Plantings = append(res.Plantings, RepoToProtoEventPlanting(plantingRes))
+	}
+
+	return res, nil
+}
+
+func (cs *communityService) ListEventPlantings(ctx context.Context, listReq *com.ListEventPlantingsRequest) (*com.ListEventPlantingsResponse, error) {
+	const action = "error listing event plantings"
+	if listReq.EventId == "" {
+		return nil, invalidArgument(action, "event_id", "event ID is empty")
+	}
+
+	event, err := cs.storage.Event().GetCommunityEvent(ctx, listReq.EventId)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+	if err := checkEventVisible(ctx, cs.storage, action, event); err != nil {
+		return nil, err
+	}
+
+	plantingsRes, err := cs.storage.Event().ListEventPlantings(ctx, listReq.EventId)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	var plantings []*com.EventPlanting
+	for _, planting := range plantingsRes {
+		plantings = append(plantings, RepoToProtoEventPlanting(planting))
+	}
+
+	return &com.ListEventPlantingsResponse{Plantings: plantings}, nil
+}
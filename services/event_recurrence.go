@@ -0,0 +1,282 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	com "github.com/Projects/ComunityService/genproto/CommunityService"
+	"github.com/Projects/ComunityService/storage"
+	"github.com/teambition/rrule-go"
+)
+
+const (
+	// defaultOccurrenceWindow is used when a request doesn't bound the occurrences it wants.
+	defaultOccurrenceWindow = 90 * 24 * time.Hour
+	maxOccurrenceWindow     = 366 * 24 * time.Hour
+	maxOccurrences          = 500
+	// maxRecurrenceYears is how long after its start a recurring event keeps occurring.
+	// Expansion walks the rule from its start, so this bounds the work of any window.
+	maxRecurrenceYears = 10
+)
+
+// occurrence is one instance of an event after applying its exception, if any.
+type occurrence struct {
+	OriginalStart time.Time
+	Start         time.Time
+	End           time.Time
+	Cancelled     bool
+	Moved         bool
+}
+
+// parseRecurrence builds the event's rule anchored at its start time. Occurrences are
+// computed in UTC. Rules repeating more than once a day are rejected, since each expansion
+// walks every occurrence up to the end of the requested window.
+func parseRecurrence(event *storage.Event) (*rrule.RRule, error) {
+	rule := strings.TrimPrefix(strings.TrimSpace(event.RecurrenceRule), "RRULE:")
+	if strings.ContainsAny(rule, "\r\n") || strings.Contains(rule, "DTSTART") {
+		return nil, fmt.Errorf("recurrence_rule must not set DTSTART, occurrences start at start_time")
+	}
+
+	opt, err := rrule.StrToROption(rule)
+	if err != nil {
+		return nil, err
+	}
+	// Frequencies are ordered from YEARLY to SECONDLY.
+	if opt.Freq > rrule.DAILY {
+		return nil, fmt.Errorf("FREQ must be DAILY or less frequent, got %s", opt.Freq)
+	}
+	if len(opt.Byhour) > 1 || len(opt.Byminute) > 1 || len(opt.Bysecond) > 1 {
+		return nil, fmt.Errorf("BYHOUR, BYMINUTE and BYSECOND take at most one value")
+	}
+	opt.Dtstart = event.StartTime.UTC()
+	return rrule.NewRRule(*opt)
+}
+
+func validateRecurrence(event *storage.Event) error {
+	if _, err := parseRecurrence(event); err != nil {
+		return &fieldError{field: "recurrence_rule", err: fmt.Errorf("invalid recurrence_rule %q: %v", event.RecurrenceRule, err)}
+	}
+	return nil
+}
+
+// recurrenceEnd is the last time the rule can produce an occurrence.
+func recurrenceEnd(rule *rrule.RRule) time.Time {
+	return rule.OrigOptions.Dtstart.AddDate(maxRecurrenceYears, 0, 0)
+}
+
+// isOccurrence reports whether the rule generates an occurrence starting exactly at t.
+func isOccurrence(rule *rrule.RRule, t time.Time) bool {
+	if t.After(recurrenceEnd(rule)) {
+		return false
+	}
+	return len(rule.Between(t, t, true)) > 0
+}
+
+// expandOccurrences returns the occurrences of a recurring event that start within
+// [from, to], with cancelled and moved exceptions applied. A moved occurrence is included
+// when its new start falls in the window, wherever it was originally scheduled. The rule
+// is only followed up to recurrenceEnd.
+func expandOccurrences(event *storage.Event, exceptions []*storage.EventException, from, to time.Time) ([]occurrence, error) {
+	rule, err := parseRecurrence(event)
+	if err != nil {
+		return nil, err
+	}
+
+	duration := event.EndTime.Sub(event.StartTime)
+	byStart := map[int64]*storage.EventException{}
+	for _, ex := range exceptions {
+		byStart[ex.OriginalStart.Unix()] = ex
+	}
+
+	last := to
+	if end := recurrenceEnd(rule); last.After(end) {
+		last = end
+	}
+
+	occurrences := []occurrence{}
+	next := rule.Iterator()
+	for {
+		start, ok := next()
+		if !ok || start.After(last) {
+			break
+		}
+		if start.Before(from) {
+			continue
+		}
+		occ := occurrence{OriginalStart: start, Start: start, End: start.Add(duration)}
+		if ex, ok := byStart[start.Unix()]; ok {
+			if ex.Cancelled {
+				occ.Cancelled = true
+			} else if ex.StartTime != nil && ex.EndTime != nil {
+				// Reported below if it still falls in the window.
+				continue
+			}
+		}
+		occurrences = append(occurrences, occ)
+		if len(occurrences) >= maxOccurrences {
+			break
+		}
+	}
+
+	for _, ex := range exceptions {
+		if ex.Cancelled || ex.StartTime == nil || ex.EndTime == nil {
+			continue
+		}
+		if ex.StartTime.Before(from) || ex.StartTime.After(to) || !isOccurrence(rule, ex.OriginalStart) {
+			continue
+		}
+		occurrences = append(occurrences, occurrence{
+			OriginalStart: ex.OriginalStart,
+			Start:         *ex.StartTime,
+			End:           *ex.EndTime,
+			Moved:         true,
+		})
+	}
+
+	sort.Slice(occurrences, func(i, j int) bool {
+		return occurrences[i].Start.Before(occurrences[j].Start)
+	})
+	if len(occurrences) > maxOccurrences {
+		occurrences = occurrences[:maxOccurrences]
+	}
+	return occurrences, nil
+}
+
+// occurrenceWindow parses the optional RFC 3339 bounds of an occurrence query.
+func occurrenceWindow(fromStr, toStr string) (time.Time, time.Time, error) {
+	from := time.Now()
+	if fromStr != "" {
+		t, err := time.Parse(timeLayout, fromStr)
+		if err != nil {
+			return time.Time{}, time.Time{}, &fieldError{field: "from", err: fmt.Errorf("invalid from %q: %v", fromStr, err)}
+		}
+		from = t
+	}
+
+	to := from.Add(defaultOccurrenceWindow)
+	if toStr != "" {
+		t, err := time.Parse(timeLayout, toStr)
+		if err != nil {
+			return time.Time{}, time.Time{}, &fieldError{field: "to", err: fmt.Errorf("invalid to %q: %v", toStr, err)}
+		}
+		to = t
+	}
+
+	if to.Before(from) {
+		return time.Time{}, time.Time{}, &fieldError{field: "to", err: fmt.Errorf("to must not be before from")}
+	}
+	if to.Sub(from) > maxOccurrenceWindow {
+		return time.Time{}, time.Time{}, &fieldError{field: "to", err: fmt.Errorf("window must not exceed %d days", int(maxOccurrenceWindow.Hours()/24))}
+	}
+	return from, to, nil
+}
+
+func RepoToProtoOccurrence(eventID string, occ occurrence) *com.EventOccurrence {
+	return &com.EventOccurrence{
+		EventId:           eventID,
+		OriginalStartTime: occ.OriginalStart.Format(timeLayout),
+		StartTime:         occ.Start.Format(timeLayout),
+		EndTime:           occ.End.Format(timeLayout),
+		Cancelled:         occ.Cancelled,
+		Moved:             occ.Moved,
+	}
+}
+
+// eventOccurrences expands a recurring event within the window, loading its exceptions.
+// When keep is set only the occurrences it accepts are returned.
+func (cs *communityService) eventOccurrences(ctx context.Context, event *storage.Event, from, to time.Time, keep func(occurrence) bool) ([]*com.EventOccurrence, error) {
+	exceptions, err := cs.storage.Event().ListEventExceptions(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences, err := expandOccurrences(event, exceptions, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*com.EventOccurrence
+	for _, occ := range occurrences {
+		if keep != nil && !keep(occ) {
+			continue
+		}
+		res = append(res, RepoToProtoOccurrence(event.ID, occ))
+	}
+	return res, nil
+}
+
+func (cs *communityService) SetEventOccurrenceException(ctx context.Context, exReq *com.SetEventOccurrenceExceptionRequest) (*com.SetEventOccurrenceExceptionResponse, error) {
+	const action = "error updating event occurrence"
+	userID, err := callerID(ctx, action, exReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if exReq.EventId == "" {
+		return nil, invalidArgument(action, "event_id", "event ID is empty")
+	}
+	originalStart, err := time.Parse(timeLayout, exReq.OriginalStartTime)
+	if err != nil {
+		return nil, invalidArgument(action, "original_start_time", fmt.Sprintf("invalid original_start_time %q: %v", exReq.OriginalStartTime, err))
+	}
+
+	event, err := cs.storage.Event().GetCommunityEvent(ctx, exReq.EventId)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+	if err := authorize(ctx, cs.storage, action, permEventUpdate, event.CommunityID, userID); err != nil {
+		return nil, err
+	}
+	if event.RecurrenceRule == "" {
+		return nil, invalidArgument(action, "event_id", "event is not recurring")
+	}
+
+	rule, err := parseRecurrence(event)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+	originalStart = originalStart.UTC()
+	if !isOccurrence(rule, originalStart) {
+		return nil, invalidArgument(action, "original_start_time", "event has no occurrence at original_start_time")
+	}
+
+	exception := &storage.EventException{
+		EventID:       event.ID,
+		OriginalStart: originalStart,
+		Cancelled:     exReq.Cancel,
+	}
+	if !exReq.Cancel {
+		start, err := time.Parse(timeLayout, exReq.StartTime)
+		if err != nil {
+			return nil, invalidArgument(action, "start_time", fmt.Sprintf("invalid start_time %q: %v", exReq.StartTime, err))
+		}
+		end, err := time.Parse(timeLayout, exReq.EndTime)
+		if err != nil {
+			return nil, invalidArgument(action, "end_time", fmt.Sprintf("invalid end_time %q: %v", exReq.EndTime, err))
+		}
+		if !start.Before(end) {
+			return nil, invalidArgument(action, "start_time", "start_time must be before end_time")
+		}
+		exception.StartTime = &start
+		exception.EndTime = &end
+	}
+
+	exRes, err := cs.storage.Event().SetEventException(ctx, exception)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	occ := occurrence{
+		OriginalStart: exRes.OriginalStart,
+		Start:         exRes.OriginalStart,
+		End:           exRes.OriginalStart.Add(event.EndTime.Sub(event.StartTime)),
+		Cancelled:     exRes.Cancelled,
+	}
+	if !exRes.Cancelled {
+		occ.Start, occ.End, occ.Moved = *exRes.StartTime, *exRes.EndTime, true
+	}
+
+	return &com.SetEventOccurrenceExceptionResponse{Occurrence: RepoToProtoOccurrence(event.ID, occ)}, nil
+}
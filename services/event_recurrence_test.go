@@ -0,0 +1,297 @@
+package services
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Projects/ComunityService/storage"
+)
+
+var recurrenceStart = time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+
+// recurringEvent is a two hour event starting at recurrenceStart.
+func recurringEvent(rule string) *storage.Event {
+	return &storage.Event{
+		ID:             "e1",
+		StartTime:      recurrenceStart,
+		EndTime:        recurrenceStart.Add(2 * time.Hour),
+		RecurrenceRule: rule,
+	}
+}
+
+func TestRecurrenceHorizon(t *testing.T) {
+	end := recurrenceStart.AddDate(maxRecurrenceYears, 0, 0)
+
+	tests := []struct {
+		name     string
+		from, to time.Time
+		want     int
+		wantLast time.Time
+	}{
+		{name: "window past the horizon", from: time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC), to: time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{name: "window across the horizon", from: end.AddDate(0, 0, -3), to: end.AddDate(0, 0, 30), want: 4, wantLast: end},
+		{name: "window before the horizon", from: end.AddDate(0, 0, -40), to: end.AddDate(0, 0, -31), want: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			started := time.Now()
+			got, err := expandOccurrences(recurringEvent("FREQ=DAILY"), nil, tt.from, tt.to)
+			if err != nil {
+				t.Fatalf("expandOccurrences: %v", err)
+			}
+			if elapsed := time.Since(started); elapsed > time.Second {
+				t.Errorf("expansion took %s", elapsed)
+			}
+			if len(got) != tt.want {
+				t.Fatalf("got %d occurrences, want %d", len(got), tt.want)
+			}
+			if !tt.wantLast.IsZero() && !got[len(got)-1].Start.Equal(tt.wantLast) {
+				t.Errorf("last occurrence at %s, want %s", got[len(got)-1].Start, tt.wantLast)
+			}
+		})
+	}
+}
+
+func TestIsOccurrencePastHorizon(t *testing.T) {
+	rule, err := parseRecurrence(recurringEvent("FREQ=DAILY"))
+	if err != nil {
+		t.Fatalf("parseRecurrence: %v", err)
+	}
+	end := recurrenceStart.AddDate(maxRecurrenceYears, 0, 0)
+	if !isOccurrence(rule, end) {
+		t.Errorf("no occurrence at the horizon %s", end)
+	}
+	if isOccurrence(rule, end.AddDate(0, 0, 1)) {
+		t.Errorf("occurrence after the horizon")
+	}
+	if isOccurrence(rule, time.Date(9999, 1, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("occurrence in year 9999")
+	}
+}
+
+func TestParseRecurrence(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    string
+		wantErr string
+	}{
+		{name: "weekly", rule: "FREQ=WEEKLY;BYDAY=SA"},
+		{name: "RRULE prefix", rule: "RRULE:FREQ=MONTHLY;BYMONTHDAY=1"},
+		{name: "daily with a single time", rule: "FREQ=DAILY;BYHOUR=9;BYMINUTE=30"},
+		{name: "until", rule: "FREQ=DAILY;UNTIL=20250310T100000Z"},
+		{name: "count", rule: "FREQ=WEEKLY;COUNT=4"},
+		{name: "hourly", rule: "FREQ=HOURLY", wantErr: "FREQ must be DAILY or less frequent"},
+		{name: "minutely", rule: "FREQ=MINUTELY;COUNT=5", wantErr: "FREQ must be DAILY or less frequent"},
+		{name: "several hours a day", rule: "FREQ=DAILY;BYHOUR=9,17", wantErr: "take at most one value"},
+		{name: "several minutes", rule: "FREQ=WEEKLY;BYMINUTE=0,30", wantErr: "take at most one value"},
+		{name: "dtstart", rule: "DTSTART:20250301T100000Z\nRRULE:FREQ=DAILY", wantErr: "must not set DTSTART"},
+		{name: "exdate", rule: "FREQ=DAILY;EXDATE=20250302T100000Z", wantErr: "EXDATE"},
+		{name: "unknown frequency", rule: "FREQ=SOMETIMES", wantErr: "undefined frequency"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseRecurrence(recurringEvent(tt.rule))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("parseRecurrence: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("parseRecurrence error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExpandOccurrences(t *testing.T) {
+	day := func(n int) time.Time { return recurrenceStart.AddDate(0, 0, n) }
+	at := func(days ...int) []time.Time {
+		var res []time.Time
+		for _, n := range days {
+			res = append(res, day(n))
+		}
+		return res
+	}
+	moved := day(2).Add(5 * time.Hour)
+
+	tests := []struct {
+		name       string
+		rule       string
+		exceptions []*storage.EventException
+		from, to   time.Time
+		wantStarts []time.Time
+		// wantCancelled and wantMoved list the indexes of wantStarts expected to be so.
+		wantCancelled []int
+		wantMoved     []int
+	}{
+		{name: "count", rule: "FREQ=DAILY;COUNT=3", from: day(-1), to: day(30), wantStarts: at(0, 1, 2)},
+		{name: "until is inclusive", rule: "FREQ=DAILY;UNTIL=20250303T100000Z", from: day(-1), to: day(30), wantStarts: at(0, 1, 2)},
+		{name: "weekly", rule: "FREQ=WEEKLY", from: day(0), to: day(21), wantStarts: at(0, 7, 14, 21)},
+		{name: "window bounds are inclusive", rule: "FREQ=DAILY", from: day(3), to: day(5), wantStarts: at(3, 4, 5)},
+		{name: "window between occurrences", rule: "FREQ=WEEKLY", from: day(1), to: day(6)},
+		{name: "window before the start", rule: "FREQ=DAILY", from: day(-10), to: day(-1)},
+		{
+			name:          "cancelled occurrence is kept and flagged",
+			rule:          "FREQ=DAILY;COUNT=3",
+			exceptions:    []*storage.EventException{{OriginalStart: day(1), Cancelled: true}},
+			from:          day(0),
+			to:            day(5),
+			wantStarts:    at(0, 1, 2),
+			wantCancelled: []int{1},
+		},
+		{
+			name:       "moved occurrence in the window",
+			rule:       "FREQ=DAILY;COUNT=3",
+			exceptions: []*storage.EventException{{OriginalStart: day(1), StartTime: &moved, EndTime: timePtr(moved.Add(time.Hour))}},
+			from:       day(0),
+			to:         day(5),
+			wantStarts: []time.Time{day(0), day(2), moved},
+			wantMoved:  []int{2},
+		},
+		{
+			name:       "moved out of the window",
+			rule:       "FREQ=DAILY;COUNT=3",
+			exceptions: []*storage.EventException{{OriginalStart: day(1), StartTime: &moved, EndTime: timePtr(moved.Add(time.Hour))}},
+			from:       day(0),
+			to:         day(1),
+			wantStarts: at(0),
+		},
+		{
+			name:       "moved into the window",
+			rule:       "FREQ=DAILY;COUNT=3",
+			exceptions: []*storage.EventException{{OriginalStart: day(0), StartTime: &moved, EndTime: timePtr(moved.Add(time.Hour))}},
+			from:       day(2),
+			to:         day(5),
+			wantStarts: []time.Time{day(2), moved},
+			wantMoved:  []int{1},
+		},
+		{
+			name:       "exception for a time the rule doesn't produce",
+			rule:       "FREQ=WEEKLY;COUNT=3",
+			exceptions: []*storage.EventException{{OriginalStart: day(1), StartTime: &moved, EndTime: timePtr(moved.Add(time.Hour))}},
+			from:       day(0),
+			to:         day(5),
+			wantStarts: at(0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := expandOccurrences(recurringEvent(tt.rule), tt.exceptions, tt.from, tt.to)
+			if err != nil {
+				t.Fatalf("expandOccurrences: %v", err)
+			}
+			if len(got) != len(tt.wantStarts) {
+				t.Fatalf("got %d occurrences %v, want %v", len(got), got, tt.wantStarts)
+			}
+			for i, occ := range got {
+				if !occ.Start.Equal(tt.wantStarts[i]) {
+					t.Errorf("occurrence %d starts at %s, want %s", i, occ.Start, tt.wantStarts[i])
+				}
+				if occ.Cancelled != containsInt(tt.wantCancelled, i) {
+					t.Errorf("occurrence %d cancelled = %v", i, occ.Cancelled)
+				}
+				if occ.Moved != containsInt(tt.wantMoved, i) {
+					t.Errorf("occurrence %d moved = %v", i, occ.Moved)
+				}
+				if !occ.Moved && occ.End.Sub(occ.Start) != 2*time.Hour {
+					t.Errorf("occurrence %d lasts %s, want the event's 2h", i, occ.End.Sub(occ.Start))
+				}
+			}
+		})
+	}
+}
+
+func TestExpandOccurrencesCap(t *testing.T) {
+	got, err := expandOccurrences(recurringEvent("FREQ=DAILY"), nil, recurrenceStart, recurrenceStart.AddDate(3, 0, 0))
+	if err != nil {
+		t.Fatalf("expandOccurrences: %v", err)
+	}
+	if len(got) != maxOccurrences {
+		t.Fatalf("got %d occurrences, want the cap of %d", len(got), maxOccurrences)
+	}
+}
+
+// Occurrences are computed in UTC, so a series keeps its UTC time of day when the
+// organiser's time zone changes to or from daylight saving time.
+func TestExpandOccurrencesAcrossDST(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("no time zone data: %v", err)
+	}
+	// Clocks in Berlin go forward on 30 March 2025.
+	start := time.Date(2025, 3, 22, 10, 0, 0, 0, berlin)
+	event := &storage.Event{StartTime: start, EndTime: start.Add(time.Hour), RecurrenceRule: "FREQ=WEEKLY;COUNT=3"}
+
+	got, err := expandOccurrences(event, nil, start, start.AddDate(0, 0, 30))
+	if err != nil {
+		t.Fatalf("expandOccurrences: %v", err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("got %d occurrences, want 3", len(got))
+	}
+	for i, occ := range got {
+		if want := start.UTC().AddDate(0, 0, 7*i); !occ.Start.Equal(want) {
+			t.Errorf("occurrence %d starts at %s, want %s", i, occ.Start, want)
+		}
+		if occ.Start.Location() != time.UTC {
+			t.Errorf("occurrence %d is in %s, want UTC", i, occ.Start.Location())
+		}
+	}
+	if h := got[2].Start.In(berlin).Hour(); h != 11 {
+		t.Errorf("occurrence after the change is at %d:00 Berlin time, want 11:00", h)
+	}
+}
+
+func TestOccurrenceWindow(t *testing.T) {
+	tests := []struct {
+		name      string
+		from, to  string
+		wantSpan  time.Duration
+		wantField string
+	}{
+		{name: "defaults to 90 days", wantSpan: defaultOccurrenceWindow},
+		{name: "to defaults from from", from: "2025-03-01T00:00:00Z", wantSpan: defaultOccurrenceWindow},
+		{name: "explicit", from: "2025-03-01T00:00:00Z", to: "2025-03-08T00:00:00Z", wantSpan: 7 * 24 * time.Hour},
+		{name: "empty window", from: "2025-03-01T00:00:00Z", to: "2025-03-01T00:00:00Z"},
+		{name: "longest window", from: "2025-01-01T00:00:00Z", to: "2026-01-02T00:00:00Z", wantSpan: maxOccurrenceWindow},
+		{name: "too long", from: "2025-01-01T00:00:00Z", to: "2026-01-02T00:00:01Z", wantField: "to"},
+		{name: "to before from", from: "2025-03-08T00:00:00Z", to: "2025-03-01T00:00:00Z", wantField: "to"},
+		{name: "bad from", from: "March", wantField: "from"},
+		{name: "bad to", to: "soon", wantField: "to"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to, err := occurrenceWindow(tt.from, tt.to)
+			if tt.wantField != "" {
+				fe, ok := err.(*fieldError)
+				if !ok || fe.field != tt.wantField {
+					t.Fatalf("error = %v, want a %s field error", err, tt.wantField)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("occurrenceWindow: %v", err)
+			}
+			if span := to.Sub(from); span != tt.wantSpan {
+				t.Errorf("window spans %s, want %s", span, tt.wantSpan)
+			}
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time { return &t }
+
+func containsInt(s []int, v int) bool {
+	for _, x := range s {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,124 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	com "github.com/Projects/ComunityService/genproto/CommunityService"
+	"github.com/Projects/ComunityService/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// rsvpStatuses are the statuses a user may choose. Waitlisted is only ever assigned by storage.
+var rsvpStatuses = map[string]bool{
+	storage.RsvpGoing:    true,
+	storage.RsvpMaybe:    true,
+	storage.RsvpDeclined: true,
+}
+
+func RepoToProtoEventRsvp(repoRsvp *storage.EventRsvp) *com.EventRsvp {
+	return &com.EventRsvp{
+		EventId:   repoRsvp.EventID,
+		UserId:    repoRsvp.UserID,
+		Status:    repoRsvp.Status,
+		CreatedAt: repoRsvp.CreatedAt.Format(timeLayout),
+		UpdatedAt: repoRsvp.UpdatedAt.Format(timeLayout),
+	}
+}
+
+func (cs *communityService) RsvpEvent(ctx context.Context, rsvpReq *com.RsvpEventRequest) (*com.RsvpEventResponse, error) {
+	const action = "error responding to event"
+	userID, err := callerID(ctx, action, rsvpReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if rsvpReq.EventId == "" {
+		return nil, invalidArgument(action, "event_id", "event ID is empty")
+	}
+	if !rsvpStatuses[rsvpReq.Status] {
+		return nil, invalidArgument(action, "status", fmt.Sprintf("invalid status %q, expected going, maybe or declined", rsvpReq.Status))
+	}
+
+	event, err := cs.storage.Event().GetCommunityEvent(ctx, rsvpReq.EventId)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+	if err := authorize(ctx, cs.storage, action, permEventRsvp, event.CommunityID, userID); err != nil {
+		return nil, err
+	}
+	// RSVPs to a recurring event apply to the series, so only one-off events can have ended.
+	if event.RecurrenceRule == "" && event.EndTime.Before(time.Now()) {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s: event %s has already ended", action, event.ID)
+	}
+
+	rsvpRes, err := cs.storage.Event().RsvpEvent(ctx, &storage.EventRsvp{
+		EventID: rsvpReq.EventId,
+		UserID:  userID,
+		Status:  rsvpReq.Status,
+	})
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	return &com.RsvpEventResponse{Rsvp: RepoToProtoEventRsvp(rsvpRes)}, nil
+}
+
+func (cs *communityService) CancelRsvp(ctx context.Context, cancelReq *com.CancelRsvpRequest) (*com.CancelRsvpResponse, error) {
+	const action = "error cancelling event response"
+	userID, err := callerID(ctx, action, cancelReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if cancelReq.EventId == "" {
+		return nil, invalidArgument(action, "event_id", "event ID is empty")
+	}
+
+	promoted, err := cs.storage.Event().CancelRsvp(ctx, cancelReq.EventId, userID)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	res := &com.CancelRsvpResponse{Message: "RSVP cancelled successfully"}
+	if promoted != nil {
+		res.Promoted = RepoToProtoEventRsvp(promoted)
+	}
+	return res, nil
+}
+
+func (cs *communityService) ListEventAttendees(ctx context.Context, listReq *com.ListEventAttendeesRequest) (*com.ListEventAttendeesResponse, error) {
+	const action = "error listing event attendees"
+	if listReq.EventId == "" {
+		return nil, invalidArgument(action, "event_id", "event ID is empty")
+	}
+	if listReq.Status != "" && !rsvpStatuses[listReq.Status] && listReq.Status != storage.RsvpWaitlisted {
+		return nil, invalidArgument(action, "status", fmt.Sprintf("invalid status %q", listReq.Status))
+	}
+
+	event, err := cs.storage.Event().GetCommunityEvent(ctx, listReq.EventId)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+	if err := checkEventVisible(ctx, cs.storage, action, event); err != nil {
+		return nil, err
+	}
+
+	limit, offset := normalizePage(listReq.Limit, listReq.Offset)
+	attendeesRes, err := cs.storage.Event().ListEventAttendees(ctx, &storage.AttendeeListFilter{
+		EventID: listReq.EventId,
+		Status:  listReq.Status,
+		Limit:   limit,
+		Offset:  offset,
+	})
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	var attendees []*com.EventRsvp
+	for _, rsvp := range attendeesRes {
+		attendees = append(attendees, RepoToProtoEventRsvp(rsvp))
+	}
+
+	return &com.ListEventAttendeesResponse{Attendees: attendees}, nil
+}
@@ -0,0 +1,129 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	com "github.com/Projects/ComunityService/genproto/CommunityService"
+	"github.com/Projects/ComunityService/storage"
+)
+
+// newTestEvent creates a workshop tomorrow in the community with the given capacity.
+func newTestEvent(t *testing.T, cs *communityService, communityID string, capacity int32) string {
+	t.Helper()
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	res, err := cs.CreateCommunityEvent(as(ownerID), &com.CreateCommunityEventRequest{Event: &com.Event{
+		CommunityId: communityID,
+		Name:        "Pruning workshop",
+		EventType:   "workshop",
+		StartTime:   start.Format(timeLayout),
+		EndTime:     start.Add(2 * time.Hour).Format(timeLayout),
+		Capacity:    capacity,
+	}})
+	if err != nil {
+		t.Fatalf("CreateCommunityEvent: %v", err)
+	}
+	return res.Event.Id
+}
+
+func rsvpStatusesOf(t *testing.T, st storage.IStorage, eventID string) map[string]string {
+	t.Helper()
+	rsvps, err := st.Event().ListEventAttendees(context.Background(), &storage.AttendeeListFilter{EventID: eventID, Limit: 10})
+	if err != nil {
+		t.Fatalf("ListEventAttendees: %v", err)
+	}
+	res := map[string]string{}
+	for _, r := range rsvps {
+		res[r.UserID] = r.Status
+	}
+	return res
+}
+
+// rsvpGoing has the users RSVP going to the event in order.
+func rsvpGoing(t *testing.T, cs *communityService, eventID string, userIDs ...string) {
+	t.Helper()
+	for _, userID := range userIDs {
+		if _, err := cs.RsvpEvent(as(userID), &com.RsvpEventRequest{EventId: eventID, Status: storage.RsvpGoing}); err != nil {
+			t.Fatalf("RsvpEvent(%s): %v", userID, err)
+		}
+		// RSVPs are ordered by time, so keep them apart.
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestRsvpWaitlistPromotion(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int32
+		// then runs after alice, bob and carol RSVP going in that order and returns the
+		// RSVP promoted by it, if any.
+		then         func(t *testing.T, cs *communityService, eventID string) *com.EventRsvp
+		wantPromoted string
+		want         map[string]string
+	}{
+		{
+			name:     "no capacity takes everyone",
+			capacity: 0,
+			want:     map[string]string{aliceID: storage.RsvpGoing, bobID: storage.RsvpGoing, carolID: storage.RsvpGoing},
+		},
+		{
+			name:     "full event waitlists in order",
+			capacity: 1,
+			want:     map[string]string{aliceID: storage.RsvpGoing, bobID: storage.RsvpWaitlisted, carolID: storage.RsvpWaitlisted},
+		},
+		{
+			name:     "cancelling promotes the longest waiting",
+			capacity: 1,
+			then: func(t *testing.T, cs *communityService, eventID string) *com.EventRsvp {
+				res, err := cs.CancelRsvp(as(aliceID), &com.CancelRsvpRequest{EventId: eventID})
+				if err != nil {
+					t.Fatalf("CancelRsvp: %v", err)
+				}
+				return res.Promoted
+			},
+			wantPromoted: bobID,
+			want:         map[string]string{bobID: storage.RsvpGoing, carolID: storage.RsvpWaitlisted},
+		},
+		{
+			name:     "cancelling a waitlisted rsvp promotes nobody",
+			capacity: 1,
+			then: func(t *testing.T, cs *communityService, eventID string) *com.EventRsvp {
+				res, err := cs.CancelRsvp(as(bobID), &com.CancelRsvpRequest{EventId: eventID})
+				if err != nil {
+					t.Fatalf("CancelRsvp: %v", err)
+				}
+				return res.Promoted
+			},
+			want: map[string]string{aliceID: storage.RsvpGoing, carolID: storage.RsvpWaitlisted},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs, st := newTestService(t)
+			communityID := newTestCommunity(t, cs, "", aliceID, bobID, carolID)
+			eventID := newTestEvent(t, cs, communityID, tt.capacity)
+
+			rsvpGoing(t, cs, eventID, aliceID, bobID, carolID)
+
+			var promoted *com.EventRsvp
+			if tt.then != nil {
+				promoted = tt.then(t, cs, eventID)
+			}
+			if got := promoted.GetUserId(); got != tt.wantPromoted {
+				t.Errorf("promoted %q, want %q", got, tt.wantPromoted)
+			}
+
+			got := rsvpStatusesOf(t, st, eventID)
+			if len(got) != len(tt.want) {
+				t.Fatalf("rsvps = %v, want %v", got, tt.want)
+			}
+			for userID, want := range tt.want {
+				if got[userID] != want {
+					t.Errorf("rsvp of %s = %q, want %q", userID, got[userID], want)
+				}
+			}
+		})
+	}
+}
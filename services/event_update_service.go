@@ -0,0 +1,196 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	com "github.com/Projects/ComunityService/genproto/CommunityService"
+	"github.com/Projects/ComunityService/storage"
+)
+
+func RepoToProtoEventChange(repoChange *storage.EventChange) *com.EventChange {
+	change := &com.EventChange{
+		Id:         repoChange.ID,
+		EventId:    repoChange.EventID,
+		ChangedBy:  repoChange.ChangedBy,
+		ChangeType: repoChange.ChangeType,
+		Reason:     repoChange.Reason,
+		CreatedAt:  repoChange.CreatedAt.Format(timeLayout),
+	}
+	for _, fc := range repoChange.Changes {
+		change.Changes = append(change.Changes, &com.EventFieldChange{
+			Field:    fc.Field,
+			OldValue: fc.OldValue,
+			NewValue: fc.NewValue,
+		})
+	}
+	return change
+}
+
+// eventUpdate turns the update mask into a storage update, applying it to a copy of the
+// current event so the result can be validated as a whole.
+func eventUpdate(current *storage.Event, protoEvent *com.Event, paths []string) (*storage.EventUpdateFilter, *storage.Event, error) {
+	update := &storage.EventUpdateFilter{ID: &current.ID}
+	merged := *current
+	var setCoordinates bool
+
+	for _, path := range paths {
+		switch path {
+		case "name":
+			update.Name = &protoEvent.Name
+			merged.Name = protoEvent.Name
+		case "description":
+			update.Description = &protoEvent.Description
+			merged.Description = protoEvent.Description
+		case "event_type":
+			update.EventType = &protoEvent.EventType
+			merged.EventType = protoEvent.EventType
+		case "start_time":
+			t, err := time.Parse(timeLayout, protoEvent.StartTime)
+			if err != nil {
+				return nil, nil, &fieldError{field: "start_time", err: fmt.Errorf("invalid start_time %q: %v", protoEvent.StartTime, err)}
+			}
+			update.StartTime = &t
+			merged.StartTime = t
+		case "end_time":
+			t, err := time.Parse(timeLayout, protoEvent.EndTime)
+			if err != nil {
+				return nil, nil, &fieldError{field: "end_time", err: fmt.Errorf("invalid end_time %q: %v", protoEvent.EndTime, err)}
+			}
+			update.EndTime = &t
+			merged.EndTime = t
+		case "location":
+			update.Location = &protoEvent.Location
+			merged.Location = protoEvent.Location
+		case "latitude":
+			setCoordinates = true
+			merged.Latitude = &protoEvent.Latitude
+		case "longitude":
+			setCoordinates = true
+			merged.Longitude = &protoEvent.Longitude
+		case "capacity":
+			update.Capacity = &protoEvent.Capacity
+			merged.Capacity = nil
+			if protoEvent.Capacity != 0 {
+				merged.Capacity = &protoEvent.Capacity
+			}
+		case "recurrence_rule":
+			update.RecurrenceRule = &protoEvent.RecurrenceRule
+			merged.RecurrenceRule = protoEvent.RecurrenceRule
+		case "garden_id":
+			update.GardenID = &protoEvent.GardenId
+			merged.GardenID = protoEvent.GardenId
+		default:
+			return nil, nil, &fieldError{field: "update_mask", err: fmt.Errorf("unsupported update_mask path %q", path)}
+		}
+	}
+
+	// Coordinates are stored as a pair, so a change to either one writes both.
+	if setCoordinates {
+		merged.Latitude, merged.Longitude = coordinates(coordinate(merged.Latitude), coordinate(merged.Longitude))
+		if merged.Latitude == nil {
+			update.ClearCoordinates = true
+		} else {
+			update.Latitude, update.Longitude = merged.Latitude, merged.Longitude
+		}
+	}
+
+	return update, &merged, nil
+}
+
+func (cs *communityService) UpdateCommunityEvent(ctx context.Context, upReq *com.UpdateCommunityEventRequest) (*com.UpdateCommunityEventResponse, error) {
+	const action = "error updating community event"
+	userID, err := callerID(ctx, action, upReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if upReq.Event == nil || upReq.Event.Id == "" {
+		return nil, invalidArgument(action, "event.id", "event ID is empty")
+	}
+	if len(upReq.UpdateMask.GetPaths()) == 0 {
+		return nil, invalidArgument(action, "update_mask", "update_mask is empty")
+	}
+
+	current, err := cs.storage.Event().GetCommunityEvent(ctx, upReq.Event.Id)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+	if err := authorize(ctx, cs.storage, action, permEventUpdate, current.CommunityID, userID); err != nil {
+		return nil, err
+	}
+
+	update, merged, err := eventUpdate(current, upReq.Event, upReq.UpdateMask.GetPaths())
+	if err != nil {
+		return nil, fieldStatus(action, err)
+	}
+	if err := validateEvent(merged); err != nil {
+		return nil, fieldStatus(action, err)
+	}
+	if update.GardenID != nil && *update.GardenID != "" {
+		if err := cs.validateEventGarden(ctx, action, *update.GardenID); err != nil {
+			return nil, err
+		}
+	}
+
+	eventRes, err := cs.storage.Event().UpdateCommunityEvent(ctx, update, userID)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	return &com.UpdateCommunityEventResponse{Event: RepoToProtoEvent(eventRes)}, nil
+}
+
+func (cs *communityService) DeleteCommunityEvent(ctx context.Context, delReq *com.DeleteCommunityEventRequest) (*com.DeleteCommunityEventResponse, error) {
+	const action = "error cancelling community event"
+	userID, err := callerID(ctx, action, delReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if delReq.Id == "" {
+		return nil, invalidArgument(action, "id", "event ID is empty")
+	}
+
+	event, err := cs.storage.Event().GetCommunityEvent(ctx, delReq.Id)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+	if err := authorize(ctx, cs.storage, action, permEventCancel, event.CommunityID, userID); err != nil {
+		return nil, err
+	}
+
+	if err := cs.storage.Event().DeleteCommunityEvent(ctx, delReq.Id, userID, delReq.Reason); err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	return &com.DeleteCommunityEventResponse{Message: "Event cancelled successfully"}, nil
+}
+
+func (cs *communityService) ListEventChanges(ctx context.Context, listReq *com.ListEventChangesRequest) (*com.ListEventChangesResponse, error) {
+	const action = "error listing event changes"
+	if listReq.EventId == "" {
+		return nil, invalidArgument(action, "event_id", "event ID is empty")
+	}
+
+	// A cancelled event keeps its change log, which records why it was cancelled.
+	event, err := cs.storage.Event().GetEventWithCancelled(ctx, listReq.EventId)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+	if err := checkEventVisible(ctx, cs.storage, action, event); err != nil {
+		return nil, err
+	}
+
+	limit, offset := normalizePage(listReq.Limit, listReq.Offset)
+	changesRes, err := cs.storage.Event().ListEventChanges(ctx, event.ID, limit, offset)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	var changes []*com.EventChange
+	for _, change := range changesRes {
+		changes = append(changes, RepoToProtoEventChange(change))
+	}
+
+	return &com.ListEventChangesResponse{Changes: changes}, nil
+}
@@ -0,0 +1,52 @@
+package services
+
+import (
+	"testing"
+
+	com "github.com/Projects/ComunityService/genproto/CommunityService"
+	"github.com/Projects/ComunityService/storage"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestCapacityChangePromotes(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int32
+		want     map[string]string
+	}{
+		{
+			name:     "raising the capacity promotes the waitlist",
+			capacity: 2,
+			want:     map[string]string{aliceID: storage.RsvpGoing, bobID: storage.RsvpGoing, carolID: storage.RsvpWaitlisted},
+		},
+		{
+			name:     "removing the capacity promotes the whole waitlist",
+			capacity: 0,
+			want:     map[string]string{aliceID: storage.RsvpGoing, bobID: storage.RsvpGoing, carolID: storage.RsvpGoing},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs, st := newTestService(t)
+			communityID := newTestCommunity(t, cs, "", aliceID, bobID, carolID)
+			eventID := newTestEvent(t, cs, communityID, 1)
+			rsvpGoing(t, cs, eventID, aliceID, bobID, carolID)
+
+			_, err := cs.UpdateCommunityEvent(as(ownerID), &com.UpdateCommunityEventRequest{
+				Event:      &com.Event{Id: eventID, Capacity: tt.capacity},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"capacity"}},
+			})
+			if err != nil {
+				t.Fatalf("UpdateCommunityEvent: %v", err)
+			}
+
+			got := rsvpStatusesOf(t, st, eventID)
+			for userID, want := range tt.want {
+				if got[userID] != want {
+					t.Errorf("rsvp of %s = %q, want %q", userID, got[userID], want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Projects/ComunityService/auth"
+	com "github.com/Projects/ComunityService/genproto/CommunityService"
+	garden "github.com/Projects/ComunityService/genproto/GardenManagementService"
+	user "github.com/Projects/ComunityService/genproto/UserManagementService"
+	"github.com/Projects/ComunityService/storage"
+	"github.com/Projects/ComunityService/storage/memory"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	ownerID = "00000000-0000-0000-0000-000000000001"
+	aliceID = "00000000-0000-0000-0000-000000000002"
+	bobID   = "00000000-0000-0000-0000-000000000003"
+	carolID = "00000000-0000-0000-0000-000000000004"
+)
+
+var testInviteSecret = []byte("0123456789abcdef0123456789abcdef")
+
+// fakeUsers knows every user it is asked about. Unimplemented methods panic through the
+// nil embedded client.
+type fakeUsers struct {
+	user.UserManagementServiceClient
+}
+
+func (fakeUsers) GetUserById(ctx context.Context, in *user.IdUserRequest, _ ...grpc.CallOption) (*user.UserResponse, error) {
+	return &user.UserResponse{UserId: in.UserId, Username: "user-" + in.UserId[len(in.UserId)-1:]}, nil
+}
+
+func (fakeUsers) GetUserProfileById(ctx context.Context, in *user.IdUserRequest, _ ...grpc.CallOption) (*user.UserProfileResponse, error) {
+	return &user.UserProfileResponse{UserId: in.UserId, UserProfile: &user.UserProfile{}}, nil
+}
+
+// fakeGarden creates plants with sequential IDs and fails the call numbered failAt, if set.
+// Gardens and plants are listed from the maps. A listing call for an ID in errs fails with
+// it, and one for an ID in hang waits for its context to end.
+type fakeGarden struct {
+	garden.GardenManagementServiceClient
+	plants int
+	failAt int
+
+	gardens  map[string][]*garden.GardenResponse
+	plantsOf map[string][]*garden.PlantResponse
+	errs     map[string]error
+	hang     map[string]bool
+	delay    time.Duration
+
+	mu          sync.Mutex
+	inFlight    int
+	maxInFlight int
+}
+
+// list stands in for the network part of a listing call for the ID.
+func (g *fakeGarden) list(ctx context.Context, id string) error {
+	g.mu.Lock()
+	g.inFlight++
+	if g.inFlight > g.maxInFlight {
+		g.maxInFlight = g.inFlight
+	}
+	g.mu.Unlock()
+	defer func() {
+		g.mu.Lock()
+		g.inFlight--
+		g.mu.Unlock()
+	}()
+
+	wait := time.After(g.delay)
+	if g.hang[id] {
+		wait = nil
+	}
+	select {
+	case <-wait:
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	return g.errs[id]
+}
+
+func (g *fakeGarden) GetGardensByUserID(ctx context.Context, in *garden.IdRequest, _ ...grpc.CallOption) (*garden.Gardens, error) {
+	if err := g.list(ctx, in.Id); err != nil {
+		return nil, err
+	}
+	if len(g.gardens[in.Id]) == 0 {
+		return nil, status.Errorf(codes.NotFound, "user %s has no gardens", in.Id)
+	}
+	return &garden.Gardens{Gardens: g.gardens[in.Id]}, nil
+}
+
+func (g *fakeGarden) GetPlantsByGardenID(ctx context.Context, in *garden.IdRequest, _ ...grpc.CallOption) (*garden.Plants, error) {
+	if err := g.list(ctx, in.Id); err != nil {
+		return nil, err
+	}
+	return &garden.Plants{Plants: g.plantsOf[in.Id]}, nil
+}
+
+func (g *fakeGarden) GetGardenByID(ctx context.Context, in *garden.IdRequest, _ ...grpc.CallOption) (*garden.GardenResponse, error) {
+	return &garden.GardenResponse{Id: in.Id}, nil
+}
+
+func (g *fakeGarden) CreatePlantByGardenID(ctx context.Context, in *garden.PlantRequest, _ ...grpc.CallOption) (*garden.PlantResponse, error) {
+	g.plants++
+	if g.plants == g.failAt {
+		return nil, status.Error(codes.Unavailable, "garden service unavailable")
+	}
+	return &garden.PlantResponse{Id: fmt.Sprintf("10000000-0000-0000-0000-%012d", g.plants)}, nil
+}
+
+func newTestService(t *testing.T) (*communityService, storage.IStorage) {
+	t.Helper()
+	return newTestServiceWith(t, &fakeGarden{})
+}
+
+func newTestServiceWith(t *testing.T, gardens *fakeGarden) (*communityService, storage.IStorage) {
+	t.Helper()
+	st := memory.NewStorage()
+	return NewCommunityService(st, fakeUsers{}, gardens, testInviteSecret), st
+}
+
+// as authenticates the context as the user.
+func as(userID string) context.Context {
+	return auth.NewContext(context.Background(), &auth.Identity{UserID: userID})
+}
+
+// newTestCommunity creates a community owned by ownerID and joins the members to it.
+func newTestCommunity(t *testing.T, cs *communityService, visibility string, members ...string) string {
+	t.Helper()
+	res, err := cs.CreateCommunity(as(ownerID), &com.CreateCommunityRequest{
+		Community: &com.Community{Name: "Allotment", Location: "Riverside", Visibility: visibility},
+	})
+	if err != nil {
+		t.Fatalf("CreateCommunity: %v", err)
+	}
+	for _, member := range members {
+		if _, err := cs.JoinCommunity(as(member), &com.JoinCommunityRequest{CommunityId: res.Community.Id}); err != nil {
+			t.Fatalf("JoinCommunity(%s): %v", member, err)
+		}
+	}
+	return res.Community.Id
+}
+
+// wantCode fails the test unless err carries the gRPC code.
+func wantCode(t *testing.T, err error, want codes.Code) {
+	t.Helper()
+	if got := status.Code(err); got != want {
+		t.Fatalf("got code %s (%v), want %s", got, err, want)
+	}
+}
@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+
+	com "github.com/Projects/ComunityService/genproto/CommunityService"
+	user "github.com/Projects/ComunityService/genproto/UserManagementService"
+	"github.com/Projects/ComunityService/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type forumService struct {
+	storage    storage.IStorage
+	userClient user.UserManagementServiceClient
+	com.UnimplementedForumServiceServer
+}
+
+func NewForumService(st storage.IStorage, userClient user.UserManagementServiceClient) *forumService {
+	return &forumService{
+		storage:    st,
+		userClient: userClient,
+	}
+}
+
+func RepoToProtoForumComment(repoComment *storage.ForumComment) *com.ForumComment {
+	return &com.ForumComment{
+		Id:        repoComment.ID,
+		ForumId:   repoComment.ForumID,
+		UserId:    repoComment.UserID,
+		Content:   repoComment.Content,
+		CreatedAt: repoComment.CreatedAt.Format(timeLayout),
+		UpdatedAt: repoComment.UpdatedAt.Format(timeLayout),
+	}
+}
+
+// checkMember makes sure the user exists and that the policy lets them post in the community.
+func (fs *forumService) checkMember(ctx context.Context, action, communityID, userID string) error {
+	if userID == "" {
+		return invalidArgument(action, "user_id", "user ID is empty")
+	}
+
+	if _, err := fs.userClient.GetUserById(ctx, &user.IdUserRequest{UserId: userID}); err != nil {
+		return userServiceError(action, err)
+	}
+
+	return authorize(ctx, fs.storage, action, permForumPost, communityID, userID)
+}
+
+func (fs *forumService) CreateForum(ctx context.Context, forumReq *com.CreateForumRequest) (*com.CreateForumResponse, error) {
+	const action = "error creating forum post"
+	userID, err := callerID(ctx, action, forumReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if forumReq.Title == "" {
+		return nil, invalidArgument(action, "title", "title is required")
+	}
+	if err := fs.checkMember(ctx, action, forumReq.CommunityId, userID); err != nil {
+		return nil, err
+	}
+
+	post := storage.ForumPost{
+		CommunityID: forumReq.CommunityId,
+		UserID:      userID,
+		Title:       forumReq.Title,
+		Content:     forumReq.Content,
+	}
+
+	postRes, err := fs.storage.Forum().CreateForumPost(ctx, &post)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	return &com.CreateForumResponse{
+		Id:          postRes.ID,
+		CommunityId: postRes.CommunityID,
+		UserId:      postRes.UserID,
+		Title:       postRes.Title,
+		Content:     postRes.Content,
+		CreatedAt:   postRes.CreatedAt.Format(timeLayout),
+		UpdatedAt:   postRes.UpdatedAt.Format(timeLayout),
+	}, nil
+}
+
+func (fs *forumService) GetForum(ctx context.Context, forumReq *com.GetForumRequest) (*com.GetForumResponse, error) {
+	postRes, err := fs.storage.Forum().GetForumPost(ctx, forumReq.Id)
+	if err != nil {
+		return nil, toStatus("error getting forum post", err)
+	}
+	if visible, err := contentVisible(ctx, fs.storage, postRes.CommunityID); err != nil {
+		return nil, toStatus("error getting forum post", err)
+	} else if !visible {
+		return nil, toStatus("error getting forum post", storage.NewError(storage.ErrNotFound, "forum post", "forum post %s not found", postRes.ID))
+	}
+
+	commentsRes, err := fs.storage.Forum().GetForumComments(ctx, postRes.ID)
+	if err != nil {
+		return nil, toStatus("error getting forum comments", err)
+	}
+
+	var comments []*com.ForumComment
+	for _, comment := range commentsRes {
+		comments = append(comments, RepoToProtoForumComment(comment))
+	}
+
+	forum := &com.GetForumResponse{
+		Id:          postRes.ID,
+		CommunityId: postRes.CommunityID,
+		UserId:      postRes.UserID,
+		Title:       postRes.Title,
+		Content:     postRes.Content,
+		CreatedAt:   postRes.CreatedAt.Format(timeLayout),
+		UpdatedAt:   postRes.UpdatedAt.Format(timeLayout),
+		Comments:    comments,
+	}
+	if postRes.ArchivedAt != nil {
+		forum.ArchivedAt = postRes.ArchivedAt.Format(timeLayout)
+	}
+	return forum, nil
+}
+
+func (fs *forumService) CreateForumComment(ctx context.Context, commentReq *com.CreateForumCommentRequest) (*com.CreateForumCommentResponse, error) {
+	const action = "error creating forum comment"
+	userID, err := callerID(ctx, action, commentReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if commentReq.Content == "" {
+		return nil, invalidArgument(action, "content", "content is required")
+	}
+
+	postRes, err := fs.storage.Forum().GetForumPost(ctx, commentReq.ForumId)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+	if postRes.ArchivedAt != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s: forum post %s is archived", action, postRes.ID)
+	}
+	if err := fs.checkMember(ctx, action, postRes.CommunityID, userID); err != nil {
+		return nil, err
+	}
+
+	comment := storage.ForumComment{
+		ForumID: postRes.ID,
+		UserID:  userID,
+		Content: commentReq.Content,
+	}
+
+	commentRes, err := fs.storage.Forum().CreateForumComment(ctx, &comment)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	return &com.CreateForumCommentResponse{
+		Id:        commentRes.ID,
+		ForumId:   commentRes.ForumID,
+		UserId:    commentRes.UserID,
+		Content:   commentRes.Content,
+		CreatedAt: commentRes.CreatedAt.Format(timeLayout),
+		UpdatedAt: commentRes.UpdatedAt.Format(timeLayout),
+	}, nil
+}
@@ -0,0 +1,287 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	com "github.com/Projects/ComunityService/genproto/CommunityService"
+	garden "github.com/Projects/ComunityService/genproto/GardenManagementService"
+	"github.com/Projects/ComunityService/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxConcurrentGardenCalls bounds how many garden service calls a summary makes at once.
+	maxConcurrentGardenCalls = 10
+
+	defaultTopSpecies = 10
+	maxTopSpecies     = 50
+)
+
+var (
+	// gardenCallTimeout bounds each garden service call; a call that runs over is reported
+	// as a failure and left out of the summary.
+	gardenCallTimeout = 3 * time.Second
+	// gardenSummaryTimeout bounds all the garden service calls of one summary. Calls not
+	// started by then are reported as failures rather than made.
+	gardenSummaryTimeout = 10 * time.Second
+)
+
+// gardenFailures collects failed garden service calls from concurrent workers.
+type gardenFailures struct {
+	mu       sync.Mutex
+	failures []*com.GardenSummaryFailure
+}
+
+func (f *gardenFailures) add(userID, gardenID string, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.failures = append(f.failures, &com.GardenSummaryFailure{
+		UserId:   userID,
+		GardenId: gardenID,
+		Code:     status.Code(err).String(),
+		Message:  status.Convert(err).Message(),
+	})
+}
+
+// fanOut calls fn for every index below n, running at most maxConcurrentGardenCalls at once.
+// Once ctx is done no more calls are started, and skip is called for the remaining indexes.
+func fanOut(ctx context.Context, n int, fn func(i int), skip func(i int)) {
+	sem := make(chan struct{}, maxConcurrentGardenCalls)
+	var wg sync.WaitGroup
+
+	for i := 0; i < n; i++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		// A slot and the end of ctx may both be ready; ctx wins either way.
+		if ctx.Err() != nil {
+			for ; i < n; i++ {
+				skip(i)
+			}
+			break
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			fn(i)
+		}(i)
+	}
+
+	wg.Wait()
+}
+
+// communityMemberIDs pages through the active members of the community.
+func (cs *communityService) communityMemberIDs(ctx context.Context, communityID string) ([]string, error) {
+	var ids []string
+	for offset := int32(0); ; offset += maxPageSize {
+		members, err := cs.storage.Member().ListCommunityMembers(ctx, &storage.MemberListFilter{
+			CommunityID: communityID,
+			Limit:       maxPageSize,
+			Offset:      offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			ids = append(ids, member.UserID)
+		}
+		if len(members) < maxPageSize {
+			return ids, nil
+		}
+	}
+}
+
+func (cs *communityService) GetCommunityGardenSummary(ctx context.Context, sumReq *com.GetCommunityGardenSummaryRequest) (*com.GetCommunityGardenSummaryResponse, error) {
+	const action = "error summarizing community gardens"
+	userID, err := callerID(ctx, action, sumReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if sumReq.CommunityId == "" {
+		return nil, invalidArgument(action, "community_id", "community ID is empty")
+	}
+	topSpecies := sumReq.TopSpeciesLimit
+	if topSpecies <= 0 {
+		topSpecies = defaultTopSpecies
+	}
+	if topSpecies > maxTopSpecies {
+		topSpecies = maxTopSpecies
+	}
+	if err := authorize(ctx, cs.storage, action, permGardenSummaryView, sumReq.CommunityId, userID); err != nil {
+		return nil, err
+	}
+
+	memberIDs, err := cs.communityMemberIDs(ctx, sumReq.CommunityId)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	failures := &gardenFailures{}
+	sumCtx, cancel := context.WithTimeout(ctx, gardenSummaryTimeout)
+	defer cancel()
+	skipped := func() error { return status.FromContextError(sumCtx.Err()).Err() }
+
+	memberGardens := make([][]*garden.GardenResponse, len(memberIDs))
+	fanOut(sumCtx, len(memberIDs), func(i int) {
+		callCtx, cancel := context.WithTimeout(sumCtx, gardenCallTimeout)
+		defer cancel()
+
+		gardensRes, err := cs.gardenClient.GetGardensByUserID(callCtx, &garden.IdRequest{Id: memberIDs[i]})
+		// The garden service reports a user without gardens as not found.
+		if status.Code(err) == codes.NotFound {
+			return
+		}
+		if err != nil {
+			failures.add(memberIDs[i], "", err)
+			return
+		}
+		memberGardens[i] = gardensRes.Gardens
+	}, func(i int) {
+		failures.add(memberIDs[i], "", skipped())
+	})
+
+	var gardens []*garden.GardenResponse
+	for _, g := range memberGardens {
+		gardens = append(gardens, g...)
+	}
+
+	gardenPlants := make([][]*garden.PlantResponse, len(gardens))
+	fanOut(sumCtx, len(gardens), func(i int) {
+		callCtx, cancel := context.WithTimeout(sumCtx, gardenCallTimeout)
+		defer cancel()
+
+		plantsRes, err := cs.gardenClient.GetPlantsByGardenID(callCtx, &garden.IdRequest{Id: gardens[i].Id})
+		if status.Code(err) == codes.NotFound {
+			return
+		}
+		if err != nil {
+			failures.add(gardens[i].UserId, gardens[i].Id, err)
+			return
+		}
+		gardenPlants[i] = plantsRes.Plants
+	}, func(i int) {
+		failures.add(gardens[i].UserId, gardens[i].Id, skipped())
+	})
+
+	// Partial results are only useful while the caller is still waiting for them.
+	if err := ctx.Err(); err != nil {
+		return nil, status.Errorf(status.FromContextError(err).Code(), "%s: %v", action, err)
+	}
+
+	res := summarizeGardens(gardens, gardenPlants, int(topSpecies))
+	res.MemberCount = int32(len(memberIDs))
+	for _, g := range memberGardens {
+		if len(g) > 0 {
+			res.MembersWithGardens++
+		}
+	}
+	res.Failures = failures.failures
+	res.Partial = len(res.Failures) > 0
+	sort.Slice(res.Failures, func(i, j int) bool {
+		if res.Failures[i].UserId == res.Failures[j].UserId {
+			return res.Failures[i].GardenId < res.Failures[j].GardenId
+		}
+		return res.Failures[i].UserId < res.Failures[j].UserId
+	})
+
+	return res, nil
+}
+
+// summarizeGardens aggregates the gardens and their plants; plants[i] belongs to gardens[i].
+// Species are grouped case-insensitively under the first spelling seen.
+func summarizeGardens(gardens []*garden.GardenResponse, plants [][]*garden.PlantResponse, topSpecies int) *com.GetCommunityGardenSummaryResponse {
+	res := &com.GetCommunityGardenSummaryResponse{GardenCount: int32(len(gardens))}
+
+	types := map[string]*com.GardenTypeSummary{}
+	species := map[string]*com.SpeciesSummary{}
+	statuses := map[string]*com.PlantStatusSummary{}
+
+	for i, g := range gardens {
+		res.TotalArea += float64(g.Area)
+
+		gardenType := g.Type
+		if gardenType == "" {
+			gardenType = "unknown"
+		}
+		ts, ok := types[gardenType]
+		if !ok {
+			ts = &com.GardenTypeSummary{Type: gardenType}
+			types[gardenType] = ts
+		}
+		ts.GardenCount++
+		ts.TotalArea += float64(g.Area)
+
+		inGarden := map[string]bool{}
+		for _, plant := range plants[i] {
+			name := strings.TrimSpace(plant.Species)
+			key := strings.ToLower(name)
+			if key != "" {
+				ss, ok := species[key]
+				if !ok {
+					ss = &com.SpeciesSummary{Species: name}
+					species[key] = ss
+				}
+				ss.Quantity += plant.Quantity
+				if !inGarden[key] {
+					inGarden[key] = true
+					ss.GardenCount++
+				}
+			}
+
+			plantStatus := plant.Status
+			if plantStatus == "" {
+				plantStatus = "unknown"
+			}
+			ps, ok := statuses[plantStatus]
+			if !ok {
+				ps = &com.PlantStatusSummary{Status: plantStatus}
+				statuses[plantStatus] = ps
+			}
+			ps.PlantCount++
+			ps.Quantity += plant.Quantity
+		}
+	}
+
+	for _, ts := range types {
+		res.GardenTypes = append(res.GardenTypes, ts)
+	}
+	sort.Slice(res.GardenTypes, func(i, j int) bool {
+		if res.GardenTypes[i].GardenCount == res.GardenTypes[j].GardenCount {
+			return res.GardenTypes[i].Type < res.GardenTypes[j].Type
+		}
+		return res.GardenTypes[i].GardenCount > res.GardenTypes[j].GardenCount
+	})
+
+	for _, ss := range species {
+		res.TopSpecies = append(res.TopSpecies, ss)
+	}
+	sort.Slice(res.TopSpecies, func(i, j int) bool {
+		if res.TopSpecies[i].Quantity == res.TopSpecies[j].Quantity {
+			return res.TopSpecies[i].Species < res.TopSpecies[j].Species
+		}
+		return res.TopSpecies[i].Quantity > res.TopSpecies[j].Quantity
+	})
+	if len(res.TopSpecies) > topSpecies {
+		res.TopSpecies = res.TopSpecies[:topSpecies]
+	}
+
+	for _, ps := range statuses {
+		res.PlantStatuses = append(res.PlantStatuses, ps)
+	}
+	sort.Slice(res.PlantStatuses, func(i, j int) bool {
+		if res.PlantStatuses[i].PlantCount == res.PlantStatuses[j].PlantCount {
+			return res.PlantStatuses[i].Status < res.PlantStatuses[j].Status
+		}
+		return res.PlantStatuses[i].PlantCount > res.PlantStatuses[j].PlantCount
+	})
+
+	return res
+}
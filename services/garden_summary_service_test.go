@@ -0,0 +1,236 @@
+package services
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	com "github.com/Projects/ComunityService/genproto/CommunityService"
+	garden "github.com/Projects/ComunityService/genproto/GardenManagementService"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// setGardenTimeouts shortens the garden call timeouts for the test.
+func setGardenTimeouts(t *testing.T, call, summary time.Duration) {
+	t.Helper()
+	prevCall, prevSummary := gardenCallTimeout, gardenSummaryTimeout
+	gardenCallTimeout, gardenSummaryTimeout = call, summary
+	t.Cleanup(func() { gardenCallTimeout, gardenSummaryTimeout = prevCall, prevSummary })
+}
+
+// memberIDs returns n user IDs besides the named test users.
+func memberIDs(n int) []string {
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("00000000-0000-0000-0001-%012d", i)
+	}
+	return ids
+}
+
+func TestGardenSummaryDeadline(t *testing.T) {
+	// Every call hangs until it times out. One call timeout per round of
+	// maxConcurrentGardenCalls members would take seconds; the summary deadline cuts it short.
+	members := memberIDs(25)
+	hang := map[string]bool{ownerID: true}
+	for _, id := range members {
+		hang[id] = true
+	}
+	setGardenTimeouts(t, time.Second, 100*time.Millisecond)
+
+	cs, _ := newTestServiceWith(t, &fakeGarden{hang: hang})
+	communityID := newTestCommunity(t, cs, "", members...)
+
+	started := time.Now()
+	res, err := cs.GetCommunityGardenSummary(as(ownerID), &com.GetCommunityGardenSummaryRequest{CommunityId: communityID})
+	if err != nil {
+		t.Fatalf("GetCommunityGardenSummary: %v", err)
+	}
+	if elapsed := time.Since(started); elapsed > 500*time.Millisecond {
+		t.Errorf("summary took %s", elapsed)
+	}
+
+	if !res.Partial {
+		t.Errorf("summary is not partial")
+	}
+	if len(res.Failures) != int(res.MemberCount) {
+		t.Fatalf("%d failures for %d members", len(res.Failures), res.MemberCount)
+	}
+	for _, f := range res.Failures {
+		if f.Code != codes.DeadlineExceeded.String() {
+			t.Errorf("failure of %s has code %s, want %s", f.UserId, f.Code, codes.DeadlineExceeded)
+		}
+	}
+}
+
+func TestSummarizeGardens(t *testing.T) {
+	g := func(id, gardenType string, area float32) *garden.GardenResponse {
+		return &garden.GardenResponse{Id: id, Type: gardenType, Area: area}
+	}
+	p := func(species, plantStatus string, quantity int64) *garden.PlantResponse {
+		return &garden.PlantResponse{Species: species, Status: plantStatus, Quantity: quantity}
+	}
+
+	tests := []struct {
+		name       string
+		gardens    []*garden.GardenResponse
+		plants     [][]*garden.PlantResponse
+		topSpecies int
+		want       *com.GetCommunityGardenSummaryResponse
+	}{
+		{
+			name: "no gardens",
+			want: &com.GetCommunityGardenSummaryResponse{},
+		},
+		{
+			name:    "types and areas",
+			gardens: []*garden.GardenResponse{g("g1", "allotment", 10), g("g2", "rooftop", 4), g("g3", "allotment", 6), g("g4", "", 1)},
+			plants:  make([][]*garden.PlantResponse, 4),
+			want: &com.GetCommunityGardenSummaryResponse{
+				GardenCount: 4,
+				TotalArea:   21,
+				GardenTypes: []*com.GardenTypeSummary{
+					{Type: "allotment", GardenCount: 2, TotalArea: 16},
+					{Type: "rooftop", GardenCount: 1, TotalArea: 4},
+					{Type: "unknown", GardenCount: 1, TotalArea: 1},
+				},
+			},
+		},
+		{
+			name:       "species grouped across case and gardens",
+			gardens:    []*garden.GardenResponse{g("g1", "allotment", 1), g("g2", "allotment", 1)},
+			plants:     [][]*garden.PlantResponse{{p("Tomato", "growing", 3), p("tomato ", "growing", 2)}, {p("TOMATO", "harvested", 1), p("Kale", "", 4), p(" ", "growing", 9)}},
+			topSpecies: 10,
+			want: &com.GetCommunityGardenSummaryResponse{
+				GardenCount: 2,
+				TotalArea:   2,
+				GardenTypes: []*com.GardenTypeSummary{{Type: "allotment", GardenCount: 2, TotalArea: 2}},
+				TopSpecies: []*com.SpeciesSummary{
+					{Species: "Tomato", Quantity: 6, GardenCount: 2},
+					{Species: "Kale", Quantity: 4, GardenCount: 1},
+				},
+				PlantStatuses: []*com.PlantStatusSummary{
+					{Status: "growing", PlantCount: 3, Quantity: 14},
+					{Status: "harvested", PlantCount: 1, Quantity: 1},
+					{Status: "unknown", PlantCount: 1, Quantity: 4},
+				},
+			},
+		},
+		{
+			name:       "top species cut with ties by name",
+			gardens:    []*garden.GardenResponse{g("g1", "allotment", 1)},
+			plants:     [][]*garden.PlantResponse{{p("Pea", "growing", 2), p("Bean", "growing", 2), p("Leek", "growing", 5), p("Chard", "growing", 1)}},
+			topSpecies: 2,
+			want: &com.GetCommunityGardenSummaryResponse{
+				GardenCount:   1,
+				TotalArea:     1,
+				GardenTypes:   []*com.GardenTypeSummary{{Type: "allotment", GardenCount: 1, TotalArea: 1}},
+				TopSpecies:    []*com.SpeciesSummary{{Species: "Leek", Quantity: 5, GardenCount: 1}, {Species: "Bean", Quantity: 2, GardenCount: 1}},
+				PlantStatuses: []*com.PlantStatusSummary{{Status: "growing", PlantCount: 4, Quantity: 10}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := summarizeGardens(tt.gardens, tt.plants, tt.topSpecies)
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("summary = %v\nwant %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGardenSummaryFailures(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "garden service unavailable")
+	gardens := map[string][]*garden.GardenResponse{
+		ownerID: {{Id: "g1", UserId: ownerID, Type: "allotment", Area: 5}},
+		aliceID: {{Id: "g2", UserId: aliceID, Type: "allotment", Area: 3}, {Id: "g3", UserId: aliceID, Type: "rooftop", Area: 2}},
+		bobID:   {{Id: "g4", UserId: bobID, Type: "allotment", Area: 7}},
+	}
+	plants := map[string][]*garden.PlantResponse{
+		"g1": {{Species: "Kale", Status: "growing", Quantity: 2}},
+		"g2": {{Species: "Kale", Status: "growing", Quantity: 1}},
+		"g3": {{Species: "Chard", Status: "growing", Quantity: 4}},
+	}
+
+	type failure struct{ userID, gardenID, code string }
+	tests := []struct {
+		name         string
+		errs         map[string]error
+		hang         map[string]bool
+		wantGardens  int32
+		wantMembers  int32
+		wantFailures []failure
+	}{
+		{name: "everything read", wantGardens: 4, wantMembers: 3},
+		{
+			name:         "member's gardens fail",
+			errs:         map[string]error{bobID: unavailable},
+			wantGardens:  3,
+			wantMembers:  2,
+			wantFailures: []failure{{bobID, "", "Unavailable"}},
+		},
+		{
+			name:         "one garden's plants fail",
+			errs:         map[string]error{"g3": unavailable},
+			wantGardens:  4,
+			wantMembers:  3,
+			wantFailures: []failure{{aliceID, "g3", "Unavailable"}},
+		},
+		{
+			name:         "call timeout",
+			hang:         map[string]bool{aliceID: true, "g1": true},
+			wantGardens:  2,
+			wantMembers:  2,
+			wantFailures: []failure{{ownerID, "g1", "DeadlineExceeded"}, {aliceID, "", "DeadlineExceeded"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setGardenTimeouts(t, 50*time.Millisecond, time.Second)
+			cs, _ := newTestServiceWith(t, &fakeGarden{gardens: gardens, plantsOf: plants, errs: tt.errs, hang: tt.hang})
+			communityID := newTestCommunity(t, cs, "", aliceID, bobID, carolID)
+
+			res, err := cs.GetCommunityGardenSummary(as(ownerID), &com.GetCommunityGardenSummaryRequest{CommunityId: communityID})
+			if err != nil {
+				t.Fatalf("GetCommunityGardenSummary: %v", err)
+			}
+
+			if res.MemberCount != 4 || res.GardenCount != tt.wantGardens || res.MembersWithGardens != tt.wantMembers {
+				t.Errorf("members %d, gardens %d, members with gardens %d; want 4, %d, %d",
+					res.MemberCount, res.GardenCount, res.MembersWithGardens, tt.wantGardens, tt.wantMembers)
+			}
+			if res.Partial != (len(tt.wantFailures) > 0) {
+				t.Errorf("partial = %v", res.Partial)
+			}
+			if len(res.Failures) != len(tt.wantFailures) {
+				t.Fatalf("failures = %v, want %v", res.Failures, tt.wantFailures)
+			}
+			for i, f := range res.Failures {
+				if want := tt.wantFailures[i]; f.UserId != want.userID || f.GardenId != want.gardenID || f.Code != want.code {
+					t.Errorf("failure %d = %v, want %v", i, f, want)
+				}
+			}
+		})
+	}
+}
+
+func TestGardenSummaryConcurrency(t *testing.T) {
+	members := memberIDs(3 * maxConcurrentGardenCalls)
+	g := &fakeGarden{delay: 10 * time.Millisecond}
+	cs, _ := newTestServiceWith(t, g)
+	communityID := newTestCommunity(t, cs, "", members...)
+
+	if _, err := cs.GetCommunityGardenSummary(as(ownerID), &com.GetCommunityGardenSummaryRequest{CommunityId: communityID}); err != nil {
+		t.Fatalf("GetCommunityGardenSummary: %v", err)
+	}
+	if g.maxInFlight > maxConcurrentGardenCalls {
+		t.Errorf("%d calls in flight, want at most %d", g.maxInFlight, maxConcurrentGardenCalls)
+	}
+	if g.maxInFlight < 2 {
+		t.Errorf("calls were not made concurrently")
+	}
+}
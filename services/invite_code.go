@@ -0,0 +1,51 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// signInviteCode builds the code handed out for an invite. It carries the invite ID and
+// expiry, signed so that codes can't be forged or extended.
+func signInviteCode(secret []byte, inviteID string, expiresAt time.Time) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(inviteID + "|" + strconv.FormatInt(expiresAt.Unix(), 10)))
+	return payload + "." + inviteSignature(secret, payload)
+}
+
+// verifyInviteCode checks the code's signature and expiry and returns its invite ID.
+// Whether the invite still has uses left is up to storage.
+func verifyInviteCode(secret []byte, code string, now time.Time) (string, error) {
+	payload, sig, ok := strings.Cut(code, ".")
+	if !ok || !hmac.Equal([]byte(sig), []byte(inviteSignature(secret, payload))) {
+		return "", fmt.Errorf("invalid invite code")
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return "", fmt.Errorf("invalid invite code")
+	}
+	inviteID, exp, ok := strings.Cut(string(raw), "|")
+	if !ok || inviteID == "" {
+		return "", fmt.Errorf("invalid invite code")
+	}
+	expiresAt, err := strconv.ParseInt(exp, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid invite code")
+	}
+
+	if !now.Before(time.Unix(expiresAt, 0)) {
+		return "", fmt.Errorf("invite code has expired")
+	}
+	return inviteID, nil
+}
+
+func inviteSignature(secret []byte, payload string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
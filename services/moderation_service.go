@@ -0,0 +1,207 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	com "github.com/Projects/ComunityService/genproto/CommunityService"
+	"github.com/Projects/ComunityService/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func RepoToProtoModerationAction(repoAction *storage.ModerationAction) *com.ModerationAction {
+	action := &com.ModerationAction{
+		Id:           repoAction.ID,
+		CommunityId:  repoAction.CommunityID,
+		ModeratorId:  repoAction.ModeratorID,
+		TargetUserId: repoAction.TargetUserID,
+		Action:       repoAction.Action,
+		Reason:       repoAction.Reason,
+		CreatedAt:    repoAction.CreatedAt.Format(timeLayout),
+	}
+	if repoAction.ExpiresAt != nil {
+		action.ExpiresAt = repoAction.ExpiresAt.Format(timeLayout)
+	}
+	return action
+}
+
+// checkModerationTarget keeps moderators from acting on themselves or the owner, and
+// leaves acting on another moderator to those who may change member roles.
+func (cs *communityService) checkModerationTarget(ctx context.Context, action, communityID, userID, memberID string) error {
+	if memberID == userID {
+		return invalidArgument(action, "member_id", "moderators can't act on themselves")
+	}
+
+	role, err := cs.storage.Member().GetMemberRole(ctx, communityID, memberID)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return toStatus(action, err)
+	}
+
+	switch role {
+	case storage.RoleOwner:
+		return status.Errorf(codes.FailedPrecondition, "%s: the owner of community %s can't be removed or banned", action, communityID)
+	case storage.RoleModerator:
+		return authorize(ctx, cs.storage, action, permMemberRoleChange, communityID, userID)
+	}
+	return nil
+}
+
+// checkNotBanned turns away a user with a ban in force in the community.
+func (cs *communityService) checkNotBanned(ctx context.Context, action, communityID, userID string) error {
+	ban, err := cs.storage.Moderation().GetActiveBan(ctx, communityID, userID)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return toStatus(action, err)
+	}
+
+	until := "permanently"
+	if ban.ExpiresAt != nil {
+		until = "until " + ban.ExpiresAt.Format(timeLayout)
+	}
+	return status.Errorf(codes.PermissionDenied, "%s: user %s is banned from community %s %s", action, userID, communityID, until)
+}
+
+func (cs *communityService) RemoveMember(ctx context.Context, removeReq *com.RemoveMemberRequest) (*com.ModerationActionResponse, error) {
+	const action = "error removing member"
+	userID, err := callerID(ctx, action, removeReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if removeReq.MemberId == "" {
+		return nil, invalidArgument(action, "member_id", "member ID is empty")
+	}
+	if err := authorize(ctx, cs.storage, action, permMemberRemove, removeReq.CommunityId, userID); err != nil {
+		return nil, err
+	}
+	if err := cs.checkModerationTarget(ctx, action, removeReq.CommunityId, userID, removeReq.MemberId); err != nil {
+		return nil, err
+	}
+
+	actionRes, err := cs.storage.Moderation().RemoveMember(ctx, &storage.ModerationAction{
+		CommunityID:  removeReq.CommunityId,
+		ModeratorID:  userID,
+		TargetUserID: removeReq.MemberId,
+		Action:       storage.ModerationRemove,
+		Reason:       strings.TrimSpace(removeReq.Reason),
+	})
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	return &com.ModerationActionResponse{Action: RepoToProtoModerationAction(actionRes)}, nil
+}
+
+func (cs *communityService) BanMember(ctx context.Context, banReq *com.BanMemberRequest) (*com.ModerationActionResponse, error) {
+	const action = "error banning member"
+	userID, err := callerID(ctx, action, banReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if banReq.MemberId == "" {
+		return nil, invalidArgument(action, "member_id", "member ID is empty")
+	}
+	reason := strings.TrimSpace(banReq.Reason)
+	if reason == "" {
+		return nil, invalidArgument(action, "reason", "reason is required")
+	}
+
+	var expiresAt *time.Time
+	if banReq.ExpiresAt != "" {
+		t, err := time.Parse(timeLayout, banReq.ExpiresAt)
+		if err != nil {
+			return nil, invalidArgument(action, "expires_at", fmt.Sprintf("invalid expires_at %q: %v", banReq.ExpiresAt, err))
+		}
+		if !t.After(time.Now()) {
+			return nil, invalidArgument(action, "expires_at", "expires_at must be in the future")
+		}
+		expiresAt = &t
+	}
+
+	if err := authorize(ctx, cs.storage, action, permMemberBan, banReq.CommunityId, userID); err != nil {
+		return nil, err
+	}
+	if err := cs.checkModerationTarget(ctx, action, banReq.CommunityId, userID, banReq.MemberId); err != nil {
+		return nil, err
+	}
+
+	actionRes, err := cs.storage.Moderation().BanMember(ctx, &storage.ModerationAction{
+		CommunityID:  banReq.CommunityId,
+		ModeratorID:  userID,
+		TargetUserID: banReq.MemberId,
+		Action:       storage.ModerationBan,
+		Reason:       reason,
+		ExpiresAt:    expiresAt,
+	})
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	return &com.ModerationActionResponse{Action: RepoToProtoModerationAction(actionRes)}, nil
+}
+
+func (cs *communityService) UnbanMember(ctx context.Context, unbanReq *com.UnbanMemberRequest) (*com.ModerationActionResponse, error) {
+	const action = "error unbanning member"
+	userID, err := callerID(ctx, action, unbanReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if unbanReq.MemberId == "" {
+		return nil, invalidArgument(action, "member_id", "member ID is empty")
+	}
+	if err := authorize(ctx, cs.storage, action, permMemberBan, unbanReq.CommunityId, userID); err != nil {
+		return nil, err
+	}
+
+	actionRes, err := cs.storage.Moderation().UnbanMember(ctx, &storage.ModerationAction{
+		CommunityID:  unbanReq.CommunityId,
+		ModeratorID:  userID,
+		TargetUserID: unbanReq.MemberId,
+		Action:       storage.ModerationUnban,
+		Reason:       strings.TrimSpace(unbanReq.Reason),
+	})
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	return &com.ModerationActionResponse{Action: RepoToProtoModerationAction(actionRes)}, nil
+}
+
+func (cs *communityService) ListModerationActions(ctx context.Context, listReq *com.ListModerationActionsRequest) (*com.ListModerationActionsResponse, error) {
+	const action = "error listing moderation actions"
+	userID, err := callerID(ctx, action, listReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if listReq.CommunityId == "" {
+		return nil, invalidArgument(action, "community_id", "community ID is empty")
+	}
+	if err := authorize(ctx, cs.storage, action, permModerationLogView, listReq.CommunityId, userID); err != nil {
+		return nil, err
+	}
+
+	limit, offset := normalizePage(listReq.Limit, listReq.Offset)
+	actionsRes, err := cs.storage.Moderation().ListModerationActions(ctx, &storage.ModerationLogFilter{
+		CommunityID: listReq.CommunityId,
+		Limit:       limit,
+		Offset:      offset,
+	})
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	var actions []*com.ModerationAction
+	for _, a := range actionsRes {
+		actions = append(actions, RepoToProtoModerationAction(a))
+	}
+
+	return &com.ListModerationActionsResponse{Actions: actions}, nil
+}
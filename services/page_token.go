@@ -0,0 +1,60 @@
+package services
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Projects/ComunityService/storage"
+)
+
+// encodePageToken turns the keyset position of the last row of a page into an opaque token
+// that resumes the listing right after it.
+func encodePageToken(t time.Time, id string) string {
+	raw := t.UTC().Format(time.RFC3339Nano) + "|" + id
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodePageToken(token string) (time.Time, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("malformed page token")
+	}
+
+	ts, id, ok := strings.Cut(string(raw), "|")
+	if !ok || id == "" {
+		return time.Time{}, "", fmt.Errorf("malformed page token")
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("malformed page token")
+	}
+
+	return t, id, nil
+}
+
+func encodeCommunityPageToken(c *storage.Community) string {
+	return encodePageToken(c.CreatedAt, c.ID)
+}
+
+func decodeCommunityPageToken(token string) (*storage.CommunityCursor, error) {
+	createdAt, id, err := decodePageToken(token)
+	if err != nil {
+		return nil, err
+	}
+	return &storage.CommunityCursor{CreatedAt: createdAt, ID: id}, nil
+}
+
+func encodeEventPageToken(e *storage.Event) string {
+	return encodePageToken(e.StartTime, e.ID)
+}
+
+func decodeEventPageToken(token string) (*storage.EventCursor, error) {
+	startTime, id, err := decodePageToken(token)
+	if err != nil {
+		return nil, err
+	}
+	return &storage.EventCursor{StartTime: startTime, ID: id}, nil
+}
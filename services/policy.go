@@ -0,0 +1,182 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Projects/ComunityService/auth"
+	"github.com/Projects/ComunityService/storage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// permission names something a user does within a community.
+type permission string
+
+const (
+	permCommunityUpdate     permission = "community.update"
+	permCommunityDelete     permission = "community.delete"
+	permMemberRoleChange    permission = "member.role.change"
+	permEventCreate         permission = "event.create"
+	permEventUpdate         permission = "event.update"
+	permEventCancel         permission = "event.cancel"
+	permEventRsvp           permission = "event.rsvp"
+	permEventPlantingRecord permission = "event.planting.record"
+	permGardenSummaryView   permission = "garden_summary.view"
+	permSeedListingCreate   permission = "seed_listing.create"
+	permSeedListingClaim    permission = "seed_listing.claim"
+	permSeedListingModerate permission = "seed_listing.moderate"
+	permForumPost           permission = "forum.post"
+	permJoinRequestReview   permission = "join_request.review"
+	permInviteCreate        permission = "invite.create"
+	permMemberRemove        permission = "member.remove"
+	permMemberBan           permission = "member.ban"
+	permModerationLogView   permission = "moderation_log.view"
+	permCommunityRestore    permission = "community.restore"
+	permOwnershipTransfer   permission = "community.transfer"
+)
+
+var anyMember = []string{storage.RoleOwner, storage.RoleModerator, storage.RoleMember}
+
+// rule lists who holds a permission: members with one of the roles, and global admins
+// when admin is set.
+type rule struct {
+	roles []string
+	admin bool
+}
+
+// policy is the authorization table. A permission missing from it is denied to everyone.
+var policy = map[permission]rule{
+	permCommunityUpdate:     {roles: []string{storage.RoleOwner, storage.RoleModerator}, admin: true},
+	permCommunityDelete:     {roles: []string{storage.RoleOwner}, admin: true},
+	permMemberRoleChange:    {roles: []string{storage.RoleOwner}, admin: true},
+	permEventCreate:         {roles: anyMember, admin: true},
+	permEventUpdate:         {roles: []string{storage.RoleOwner, storage.RoleModerator}, admin: true},
+	permEventCancel:         {roles: []string{storage.RoleOwner, storage.RoleModerator}, admin: true},
+	permEventRsvp:           {roles: anyMember},
+	permEventPlantingRecord: {roles: []string{storage.RoleOwner, storage.RoleModerator}, admin: true},
+	permGardenSummaryView:   {roles: anyMember, admin: true},
+	permSeedListingCreate:   {roles: anyMember},
+	permSeedListingClaim:    {roles: anyMember},
+	permSeedListingModerate: {roles: []string{storage.RoleOwner, storage.RoleModerator}, admin: true},
+	permForumPost:           {roles: anyMember},
+	permJoinRequestReview:   {roles: []string{storage.RoleOwner, storage.RoleModerator}, admin: true},
+	permInviteCreate:        {roles: []string{storage.RoleOwner, storage.RoleModerator}, admin: true},
+	permMemberRemove:        {roles: []string{storage.RoleOwner, storage.RoleModerator}, admin: true},
+	permMemberBan:           {roles: []string{storage.RoleOwner, storage.RoleModerator}, admin: true},
+	permModerationLogView:   {roles: []string{storage.RoleOwner}, admin: true},
+	permCommunityRestore:    {roles: []string{storage.RoleOwner}, admin: true},
+	permOwnershipTransfer:   {roles: []string{storage.RoleOwner}, admin: true},
+}
+
+// subject is the user a decision is made for. role is empty when the user is not a member
+// of the community, and banned is only looked up for non-members.
+type subject struct {
+	userID string
+	role   string
+	admin  bool
+	banned bool
+}
+
+// decide reports whether the subject holds the permission and, if not, why.
+func decide(perm permission, sub subject) (bool, string) {
+	r, ok := policy[perm]
+	if !ok {
+		return false, fmt.Sprintf("no policy for %s", perm)
+	}
+	if r.admin && sub.admin {
+		return true, ""
+	}
+	if sub.banned {
+		return false, "banned from the community"
+	}
+	for _, role := range r.roles {
+		if sub.role == role {
+			return true, ""
+		}
+	}
+	if sub.role == "" {
+		return false, "not a member of the community"
+	}
+	return false, fmt.Sprintf("role %s does not allow %s, need one of %s", sub.role, perm, strings.Join(r.roles, ", "))
+}
+
+// authorize checks the policy for the user acting in the community. A denial is recorded
+// in the audit log and returned as a PermissionDenied status.
+func authorize(ctx context.Context, st storage.IStorage, action string, perm permission, communityID, userID string) error {
+	if userID == "" {
+		return invalidArgument(action, "user_id", "user ID is empty")
+	}
+
+	sub, err := subjectFor(ctx, st, communityID, userID)
+	if err != nil {
+		return toStatus(action, err)
+	}
+	return enforce(ctx, st, action, perm, communityID, sub)
+}
+
+// can reports whether the user holds the permission in the community without auditing,
+// for callers that fall back to a narrower view rather than deny.
+func can(ctx context.Context, st storage.IStorage, perm permission, communityID, userID string) (bool, error) {
+	sub, err := subjectFor(ctx, st, communityID, userID)
+	if err != nil {
+		return false, err
+	}
+	allowed, _ := decide(perm, sub)
+	return allowed, nil
+}
+
+// subjectFor looks up the user's standing in the community.
+func subjectFor(ctx context.Context, st storage.IStorage, communityID, userID string) (subject, error) {
+	sub := subject{userID: userID}
+	if id, ok := auth.FromContext(ctx); ok {
+		sub.admin = id.IsAdmin()
+	}
+
+	role, err := st.Member().GetMemberRole(ctx, communityID, userID)
+	if errors.Is(err, storage.ErrNotFound) {
+		role = ""
+	} else if err != nil {
+		return subject{}, err
+	}
+	sub.role = role
+	if role == "" && !sub.admin {
+		_, err := st.Moderation().GetActiveBan(ctx, communityID, userID)
+		if err == nil {
+			sub.banned = true
+		} else if !errors.Is(err, storage.ErrNotFound) {
+			return subject{}, err
+		}
+	}
+	return sub, nil
+}
+
+// enforce decides for a subject the caller has already resolved, auditing a denial the
+// same way authorize does.
+func enforce(ctx context.Context, st storage.IStorage, action string, perm permission, communityID string, sub subject) error {
+	allowed, reason := decide(perm, sub)
+	if allowed {
+		return nil
+	}
+
+	method, _ := grpc.Method(ctx)
+	denial := &storage.AuthorizationDenial{
+		UserID:      sub.userID,
+		IsAdmin:     sub.admin,
+		Permission:  string(perm),
+		CommunityID: communityID,
+		Role:        sub.role,
+		Method:      method,
+		Reason:      reason,
+	}
+	// The caller is denied either way, so a failure to record it is only logged.
+	if err := st.Audit().RecordDenial(ctx, denial); err != nil {
+		log.Printf("failed to record authorization denial of %s for user %s: %v", perm, sub.userID, err)
+	}
+
+	return status.Errorf(codes.PermissionDenied, "%s: %s denied to user %s in community %s: %s", action, perm, sub.userID, communityID, reason)
+}
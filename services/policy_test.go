@@ -0,0 +1,91 @@
+package services
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Projects/ComunityService/storage"
+)
+
+// grants is who should hold each permission, written out by hand so that a change to
+// the policy table has to be made here as well.
+var grants = map[permission]struct{ owner, moderator, member, admin bool }{
+	permCommunityUpdate:     {owner: true, moderator: true, admin: true},
+	permCommunityDelete:     {owner: true, admin: true},
+	permMemberRoleChange:    {owner: true, admin: true},
+	permEventCreate:         {owner: true, moderator: true, member: true, admin: true},
+	permEventUpdate:         {owner: true, moderator: true, admin: true},
+	permEventCancel:         {owner: true, moderator: true, admin: true},
+	permEventRsvp:           {owner: true, moderator: true, member: true},
+	permEventPlantingRecord: {owner: true, moderator: true, admin: true},
+	permGardenSummaryView:   {owner: true, moderator: true, member: true, admin: true},
+	permSeedListingCreate:   {owner: true, moderator: true, member: true},
+	permSeedListingClaim:    {owner: true, moderator: true, member: true},
+	permSeedListingModerate: {owner: true, moderator: true, admin: true},
+	permForumPost:           {owner: true, moderator: true, member: true},
+	permJoinRequestReview:   {owner: true, moderator: true, admin: true},
+	permInviteCreate:        {owner: true, moderator: true, admin: true},
+	permMemberRemove:        {owner: true, moderator: true, admin: true},
+	permMemberBan:           {owner: true, moderator: true, admin: true},
+	permModerationLogView:   {owner: true, admin: true},
+	permCommunityRestore:    {owner: true, admin: true},
+	permOwnershipTransfer:   {owner: true, admin: true},
+}
+
+func TestPolicyCoversGrants(t *testing.T) {
+	for perm := range policy {
+		if _, ok := grants[perm]; !ok {
+			t.Errorf("%s is in the policy but has no expected grants", perm)
+		}
+	}
+	for perm := range grants {
+		if _, ok := policy[perm]; !ok {
+			t.Errorf("%s has expected grants but is missing from the policy", perm)
+		}
+	}
+}
+
+func TestDecide(t *testing.T) {
+	type tc struct {
+		name       string
+		perm       permission
+		sub        subject
+		want       bool
+		wantReason string
+	}
+	var tests []tc
+
+	for perm, g := range grants {
+		tests = append(tests,
+			tc{name: "owner", perm: perm, sub: subject{role: storage.RoleOwner}, want: g.owner, wantReason: "role owner does not allow"},
+			tc{name: "moderator", perm: perm, sub: subject{role: storage.RoleModerator}, want: g.moderator, wantReason: "role moderator does not allow"},
+			tc{name: "member", perm: perm, sub: subject{role: storage.RoleMember}, want: g.member, wantReason: "role member does not allow"},
+			tc{name: "non-member", perm: perm, sub: subject{}, wantReason: "not a member"},
+			tc{name: "banned non-member", perm: perm, sub: subject{banned: true}, wantReason: "banned from the community"},
+			// Admins only bypass the roles where the rule says so; otherwise they need
+			// the role like anyone else.
+			tc{name: "admin non-member", perm: perm, sub: subject{admin: true}, want: g.admin, wantReason: "not a member"},
+			tc{name: "admin member", perm: perm, sub: subject{admin: true, role: storage.RoleMember}, want: g.admin || g.member, wantReason: "role member does not allow"},
+			tc{name: "banned admin", perm: perm, sub: subject{admin: true, banned: true}, want: g.admin, wantReason: "banned from the community"},
+		)
+	}
+	tests = append(tests,
+		tc{name: "unknown permission", perm: "garden.burn", sub: subject{role: storage.RoleOwner}, wantReason: "no policy for garden.burn"},
+		tc{name: "unknown permission for admin", perm: "garden.burn", sub: subject{admin: true}, wantReason: "no policy for garden.burn"},
+	)
+
+	for _, tt := range tests {
+		t.Run(string(tt.perm)+"/"+tt.name, func(t *testing.T) {
+			got, reason := decide(tt.perm, tt.sub)
+			if got != tt.want {
+				t.Fatalf("decide = %v (%q), want %v", got, reason, tt.want)
+			}
+			if got && reason != "" {
+				t.Errorf("allowed with reason %q", reason)
+			}
+			if !got && !strings.Contains(reason, tt.wantReason) {
+				t.Errorf("reason = %q, want it to contain %q", reason, tt.wantReason)
+			}
+		})
+	}
+}
@@ -0,0 +1,402 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	com "github.com/Projects/ComunityService/genproto/CommunityService"
+	garden "github.com/Projects/ComunityService/genproto/GardenManagementService"
+	"github.com/Projects/ComunityService/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var seedListingKinds = map[string]bool{
+	storage.SeedOffer: true,
+	storage.SeedWant:  true,
+}
+
+var seedListingStatuses = map[string]bool{
+	storage.SeedListingOpen:    true,
+	storage.SeedListingMatched: true,
+	storage.SeedListingClosed:  true,
+}
+
+// Parties allowed to move a match between two statuses.
+const (
+	seedPartyOwner = 1 << iota
+	seedPartyClaimant
+)
+
+// seedMatchTransitions lists, for each status, the statuses a match may move to and which
+// party may move it there.
+var seedMatchTransitions = map[string]map[string]int{
+	storage.SeedMatchPending: {
+		storage.SeedMatchAccepted:  seedPartyOwner,
+		storage.SeedMatchDeclined:  seedPartyOwner,
+		storage.SeedMatchCancelled: seedPartyClaimant,
+	},
+	storage.SeedMatchAccepted: {
+		storage.SeedMatchCompleted: seedPartyOwner | seedPartyClaimant,
+		storage.SeedMatchCancelled: seedPartyOwner | seedPartyClaimant,
+	},
+}
+
+func RepoToProtoSeedListing(repoListing *storage.SeedListing) *com.SeedListing {
+	return &com.SeedListing{
+		Id:          repoListing.ID,
+		CommunityId: repoListing.CommunityID,
+		UserId:      repoListing.UserID,
+		Kind:        repoListing.Kind,
+		Species:     repoListing.Species,
+		Quantity:    repoListing.Quantity,
+		Available:   repoListing.Available,
+		GardenId:    repoListing.GardenID,
+		EventId:     repoListing.EventID,
+		Notes:       repoListing.Notes,
+		Status:      repoListing.Status,
+		CreatedAt:   repoListing.CreatedAt.Format(timeLayout),
+		UpdatedAt:   repoListing.UpdatedAt.Format(timeLayout),
+	}
+}
+
+func RepoToProtoSeedMatch(repoMatch *storage.SeedMatch) *com.SeedMatch {
+	return &com.SeedMatch{
+		Id:               repoMatch.ID,
+		ListingId:        repoMatch.ListingID,
+		ClaimantId:       repoMatch.ClaimantID,
+		CounterListingId: repoMatch.CounterListingID,
+		Quantity:         repoMatch.Quantity,
+		Status:           repoMatch.Status,
+		CreatedAt:        repoMatch.CreatedAt.Format(timeLayout),
+		UpdatedAt:        repoMatch.UpdatedAt.Format(timeLayout),
+	}
+}
+
+// gardenSpecies checks that the garden belongs to the user and returns the garden's spelling
+// of the species. When requirePlanted is false, a species the garden doesn't grow is returned
+// unchanged.
+func (cs *communityService) gardenSpecies(ctx context.Context, action, gardenID, userID, species string, requirePlanted bool) (string, error) {
+	gardenRes, err := cs.gardenClient.GetGardenByID(ctx, &garden.IdRequest{Id: gardenID})
+	if err != nil {
+		return "", gardenServiceError(action, err)
+	}
+	if gardenRes.UserId != userID {
+		return "", status.Errorf(codes.PermissionDenied, "%s: garden %s does not belong to user %s", action, gardenID, userID)
+	}
+
+	plantsRes, err := cs.gardenClient.GetPlantsByGardenID(ctx, &garden.IdRequest{Id: gardenID})
+	if err != nil {
+		return "", gardenServiceError(action, err)
+	}
+	for _, plant := range plantsRes.Plants {
+		if strings.EqualFold(strings.TrimSpace(plant.Species), species) {
+			return strings.TrimSpace(plant.Species), nil
+		}
+	}
+
+	if requirePlanted {
+		return "", invalidArgument(action, "species", fmt.Sprintf("species %q is not planted in garden %s", species, gardenID))
+	}
+	return species, nil
+}
+
+func (cs *communityService) CreateSeedListing(ctx context.Context, createReq *com.CreateSeedListingRequest) (*com.SeedListingResponse, error) {
+	const action = "error creating seed listing"
+	listing := createReq.Listing
+	if listing == nil {
+		return nil, invalidArgument(action, "listing", "listing is required")
+	}
+	userID, err := callerID(ctx, action, listing.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if listing.CommunityId == "" {
+		return nil, invalidArgument(action, "community_id", "community ID is empty")
+	}
+	if !seedListingKinds[listing.Kind] {
+		return nil, invalidArgument(action, "kind", fmt.Sprintf("invalid kind %q, expected offer or want", listing.Kind))
+	}
+	species := strings.TrimSpace(listing.Species)
+	if species == "" {
+		return nil, invalidArgument(action, "species", "species is empty")
+	}
+	if listing.Quantity <= 0 {
+		return nil, invalidArgument(action, "quantity", "quantity must be positive")
+	}
+	// Offers have to come from a garden that grows the species; a want may name the garden
+	// the seeds are for.
+	if listing.Kind == storage.SeedOffer && listing.GardenId == "" {
+		return nil, invalidArgument(action, "garden_id", "an offer requires a garden ID")
+	}
+	if err := authorize(ctx, cs.storage, action, permSeedListingCreate, listing.CommunityId, userID); err != nil {
+		return nil, err
+	}
+
+	if listing.EventId != "" {
+		event, err := cs.storage.Event().GetCommunityEvent(ctx, listing.EventId)
+		if err != nil {
+			return nil, toStatus(action, err)
+		}
+		if event.CommunityID != listing.CommunityId {
+			return nil, invalidArgument(action, "event_id", fmt.Sprintf("event %s does not belong to community %s", event.ID, listing.CommunityId))
+		}
+		if event.EventType != "seed_exchange" {
+			return nil, invalidArgument(action, "event_id", fmt.Sprintf("event %s is a %s event, not a seed_exchange", event.ID, event.EventType))
+		}
+	}
+
+	if listing.GardenId != "" {
+		species, err = cs.gardenSpecies(ctx, action, listing.GardenId, userID, species, listing.Kind == storage.SeedOffer)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	listingRes, err := cs.storage.SeedExchange().CreateSeedListing(ctx, &storage.SeedListing{
+		CommunityID: listing.CommunityId,
+		UserID:      userID,
+		Kind:        listing.Kind,
+		Species:     species,
+		Quantity:    listing.Quantity,
+		GardenID:    listing.GardenId,
+		EventID:     listing.EventId,
+		Notes:       listing.Notes,
+	})
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	return &com.SeedListingResponse{Listing: RepoToProtoSeedListing(listingRes)}, nil
+}
+
+func (cs *communityService) ListSeedListings(ctx context.Context, listReq *com.ListSeedListingsRequest) (*com.ListSeedListingsResponse, error) {
+	const action = "error listing seed listings"
+	if listReq.CommunityId == "" && listReq.EventId == "" {
+		return nil, invalidArgument(action, "community_id", "either community ID or event ID is required")
+	}
+	if listReq.Kind != "" && !seedListingKinds[listReq.Kind] {
+		return nil, invalidArgument(action, "kind", fmt.Sprintf("invalid kind %q, expected offer or want", listReq.Kind))
+	}
+	listingStatus := listReq.Status
+	if listingStatus == "" {
+		listingStatus = storage.SeedListingOpen
+	}
+	if !seedListingStatuses[listingStatus] {
+		return nil, invalidArgument(action, "status", fmt.Sprintf("invalid status %q, expected open, matched or closed", listReq.Status))
+	}
+	if listReq.CommunityId != "" {
+		if _, err := visibleCommunity(ctx, cs.storage, listReq.CommunityId); err != nil {
+			return nil, toStatus(action, err)
+		}
+	}
+	if listReq.EventId != "" {
+		event, err := cs.storage.Event().GetCommunityEvent(ctx, listReq.EventId)
+		if err != nil {
+			return nil, toStatus(action, err)
+		}
+		if err := checkEventVisible(ctx, cs.storage, action, event); err != nil {
+			return nil, err
+		}
+	}
+
+	limit, offset := normalizePage(listReq.Limit, listReq.Offset)
+	listingsRes, err := cs.storage.SeedExchange().ListSeedListings(ctx, &storage.SeedListingFilter{
+		CommunityID: listReq.CommunityId,
+		Kind:        listReq.Kind,
+		Species:     strings.TrimSpace(listReq.Species),
+		EventID:     listReq.EventId,
+		Status:      listingStatus,
+		Limit:       limit,
+		Offset:      offset,
+	})
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	var listings []*com.SeedListing
+	for _, listing := range listingsRes {
+		listings = append(listings, RepoToProtoSeedListing(listing))
+	}
+
+	return &com.ListSeedListingsResponse{Listings: listings}, nil
+}
+
+func (cs *communityService) CloseSeedListing(ctx context.Context, closeReq *com.CloseSeedListingRequest) (*com.SeedListingResponse, error) {
+	const action = "error closing seed listing"
+	userID, err := callerID(ctx, action, closeReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if closeReq.Id == "" {
+		return nil, invalidArgument(action, "id", "listing ID is empty")
+	}
+
+	listing, err := cs.storage.SeedExchange().GetSeedListing(ctx, closeReq.Id)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+	// Moderators may close listings on behalf of their authors.
+	if listing.UserID != userID {
+		if err := authorize(ctx, cs.storage, action, permSeedListingModerate, listing.CommunityID, userID); err != nil {
+			return nil, err
+		}
+	}
+	if listing.Status == storage.SeedListingClosed {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s: seed listing %s is already closed", action, listing.ID)
+	}
+
+	listingRes, err := cs.storage.SeedExchange().CloseSeedListing(ctx, listing.ID)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	return &com.SeedListingResponse{Listing: RepoToProtoSeedListing(listingRes)}, nil
+}
+
+func (cs *communityService) ClaimSeedListing(ctx context.Context, claimReq *com.ClaimSeedListingRequest) (*com.SeedMatchResponse, error) {
+	const action = "error claiming seed listing"
+	userID, err := callerID(ctx, action, claimReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if claimReq.ListingId == "" {
+		return nil, invalidArgument(action, "listing_id", "listing ID is empty")
+	}
+	if claimReq.Quantity <= 0 {
+		return nil, invalidArgument(action, "quantity", "quantity must be positive")
+	}
+
+	listing, err := cs.storage.SeedExchange().GetSeedListing(ctx, claimReq.ListingId)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+	if err := authorize(ctx, cs.storage, action, permSeedListingClaim, listing.CommunityID, userID); err != nil {
+		return nil, err
+	}
+	if listing.UserID == userID {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s: users cannot claim their own seed listing", action)
+	}
+	if listing.Status != storage.SeedListingOpen {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s: seed listing %s is %s", action, listing.ID, listing.Status)
+	}
+
+	if claimReq.CounterListingId != "" {
+		counter, err := cs.storage.SeedExchange().GetSeedListing(ctx, claimReq.CounterListingId)
+		if err != nil {
+			return nil, toStatus(action, err)
+		}
+		switch {
+		case counter.UserID != userID:
+			return nil, invalidArgument(action, "counter_listing_id", fmt.Sprintf("seed listing %s does not belong to user %s", counter.ID, userID))
+		case counter.CommunityID != listing.CommunityID:
+			return nil, invalidArgument(action, "counter_listing_id", fmt.Sprintf("seed listing %s belongs to another community", counter.ID))
+		case counter.Kind == listing.Kind:
+			return nil, invalidArgument(action, "counter_listing_id", fmt.Sprintf("seed listing %s is also a %s", counter.ID, counter.Kind))
+		case !strings.EqualFold(counter.Species, listing.Species):
+			return nil, invalidArgument(action, "counter_listing_id", fmt.Sprintf("seed listing %s is for %s, not %s", counter.ID, counter.Species, listing.Species))
+		case counter.Status != storage.SeedListingOpen:
+			return nil, invalidArgument(action, "counter_listing_id", fmt.Sprintf("seed listing %s is %s", counter.ID, counter.Status))
+		}
+	}
+
+	matchRes, err := cs.storage.SeedExchange().CreateSeedMatch(ctx, &storage.SeedMatch{
+		ListingID:        listing.ID,
+		ClaimantID:       userID,
+		CounterListingID: claimReq.CounterListingId,
+		Quantity:         claimReq.Quantity,
+	})
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	return &com.SeedMatchResponse{Match: RepoToProtoSeedMatch(matchRes)}, nil
+}
+
+func (cs *communityService) UpdateSeedMatchStatus(ctx context.Context, upReq *com.UpdateSeedMatchStatusRequest) (*com.SeedMatchResponse, error) {
+	const action = "error updating seed match"
+	userID, err := callerID(ctx, action, upReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if upReq.MatchId == "" {
+		return nil, invalidArgument(action, "match_id", "match ID is empty")
+	}
+
+	match, err := cs.storage.SeedExchange().GetSeedMatch(ctx, upReq.MatchId)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+	listing, err := cs.storage.SeedExchange().GetSeedListing(ctx, match.ListingID)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	var party int
+	if listing.UserID == userID {
+		party |= seedPartyOwner
+	}
+	if match.ClaimantID == userID {
+		party |= seedPartyClaimant
+	}
+	if party == 0 {
+		return nil, status.Errorf(codes.PermissionDenied, "%s: user %s is not a party to seed match %s", action, userID, match.ID)
+	}
+
+	allowed, ok := seedMatchTransitions[match.Status][upReq.Status]
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s: seed match %s cannot move from %s to %q", action, match.ID, match.Status, upReq.Status)
+	}
+	if allowed&party == 0 {
+		return nil, status.Errorf(codes.PermissionDenied, "%s: user %s may not mark seed match %s as %s", action, userID, match.ID, upReq.Status)
+	}
+
+	matchRes, err := cs.storage.SeedExchange().UpdateSeedMatchStatus(ctx, match.ID, match.Status, upReq.Status)
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	return &com.SeedMatchResponse{Match: RepoToProtoSeedMatch(matchRes)}, nil
+}
+
+func (cs *communityService) ListSeedMatches(ctx context.Context, listReq *com.ListSeedMatchesRequest) (*com.ListSeedMatchesResponse, error) {
+	const action = "error listing seed matches"
+	userID, err := callerID(ctx, action, listReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+	// The caller's own matches are listed, unless they ask for all matches on a listing
+	// they own or moderate.
+	if listReq.ListingId != "" && listReq.UserId == "" {
+		listing, err := cs.storage.SeedExchange().GetSeedListing(ctx, listReq.ListingId)
+		if err != nil {
+			return nil, toStatus(action, err)
+		}
+		if listing.UserID == userID {
+			userID = ""
+		} else if moderator, err := can(ctx, cs.storage, permSeedListingModerate, listing.CommunityID, userID); err != nil {
+			return nil, toStatus(action, err)
+		} else if moderator {
+			userID = ""
+		}
+	}
+
+	limit, offset := normalizePage(listReq.Limit, listReq.Offset)
+	matchesRes, err := cs.storage.SeedExchange().ListSeedMatches(ctx, &storage.SeedMatchFilter{
+		ListingID: listReq.ListingId,
+		UserID:    userID,
+		Limit:     limit,
+		Offset:    offset,
+	})
+	if err != nil {
+		return nil, toStatus(action, err)
+	}
+
+	var matches []*com.SeedMatch
+	for _, match := range matchesRes {
+		matches = append(matches, RepoToProtoSeedMatch(match))
+	}
+
+	return &com.ListSeedMatchesResponse{Matches: matches}, nil
+}
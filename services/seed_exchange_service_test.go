@@ -0,0 +1,182 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	com "github.com/Projects/ComunityService/genproto/CommunityService"
+	"github.com/Projects/ComunityService/storage"
+	"google.golang.org/grpc/codes"
+)
+
+func TestSeedMatchTransitions(t *testing.T) {
+	type move struct {
+		by     string
+		status string
+		want   codes.Code
+	}
+
+	// alice wants 5 tomato seeds and bob claims all of them.
+	tests := []struct {
+		name          string
+		moves         []move
+		wantStatus    string
+		wantListing   string
+		wantAvailable int32
+	}{
+		{
+			name:          "pending claim",
+			wantStatus:    storage.SeedMatchPending,
+			wantListing:   storage.SeedListingOpen,
+			wantAvailable: 5,
+		},
+		{
+			name:          "owner accepts",
+			moves:         []move{{aliceID, storage.SeedMatchAccepted, codes.OK}},
+			wantStatus:    storage.SeedMatchAccepted,
+			wantListing:   storage.SeedListingMatched,
+			wantAvailable: 0,
+		},
+		{
+			name:          "claimant can't accept",
+			moves:         []move{{bobID, storage.SeedMatchAccepted, codes.PermissionDenied}},
+			wantStatus:    storage.SeedMatchPending,
+			wantListing:   storage.SeedListingOpen,
+			wantAvailable: 5,
+		},
+		{
+			name:          "outsider can't touch the match",
+			moves:         []move{{carolID, storage.SeedMatchCancelled, codes.PermissionDenied}},
+			wantStatus:    storage.SeedMatchPending,
+			wantListing:   storage.SeedListingOpen,
+			wantAvailable: 5,
+		},
+		{
+			name:          "claimant withdraws",
+			moves:         []move{{bobID, storage.SeedMatchCancelled, codes.OK}},
+			wantStatus:    storage.SeedMatchCancelled,
+			wantListing:   storage.SeedListingOpen,
+			wantAvailable: 5,
+		},
+		{
+			name: "declined is final",
+			moves: []move{
+				{aliceID, storage.SeedMatchDeclined, codes.OK},
+				{aliceID, storage.SeedMatchAccepted, codes.FailedPrecondition},
+			},
+			wantStatus:    storage.SeedMatchDeclined,
+			wantListing:   storage.SeedListingOpen,
+			wantAvailable: 5,
+		},
+		{
+			name: "either party completes",
+			moves: []move{
+				{aliceID, storage.SeedMatchAccepted, codes.OK},
+				{bobID, storage.SeedMatchCompleted, codes.OK},
+				{aliceID, storage.SeedMatchCancelled, codes.FailedPrecondition},
+			},
+			wantStatus:    storage.SeedMatchCompleted,
+			wantListing:   storage.SeedListingMatched,
+			wantAvailable: 0,
+		},
+		{
+			name: "cancelling an accepted match frees the seeds",
+			moves: []move{
+				{aliceID, storage.SeedMatchAccepted, codes.OK},
+				{aliceID, storage.SeedMatchCancelled, codes.OK},
+			},
+			wantStatus:    storage.SeedMatchCancelled,
+			wantListing:   storage.SeedListingOpen,
+			wantAvailable: 5,
+		},
+		{
+			name:          "unknown status",
+			moves:         []move{{aliceID, "shipped", codes.FailedPrecondition}},
+			wantStatus:    storage.SeedMatchPending,
+			wantListing:   storage.SeedListingOpen,
+			wantAvailable: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs, st := newTestService(t)
+			communityID := newTestCommunity(t, cs, "", aliceID, bobID, carolID)
+
+			listing, err := cs.CreateSeedListing(as(aliceID), &com.CreateSeedListingRequest{Listing: &com.SeedListing{
+				CommunityId: communityID,
+				Kind:        storage.SeedWant,
+				Species:     "Tomato",
+				Quantity:    5,
+			}})
+			if err != nil {
+				t.Fatalf("CreateSeedListing: %v", err)
+			}
+			match, err := cs.ClaimSeedListing(as(bobID), &com.ClaimSeedListingRequest{ListingId: listing.Listing.Id, Quantity: 5})
+			if err != nil {
+				t.Fatalf("ClaimSeedListing: %v", err)
+			}
+
+			for _, m := range tt.moves {
+				_, err := cs.UpdateSeedMatchStatus(as(m.by), &com.UpdateSeedMatchStatusRequest{MatchId: match.Match.Id, Status: m.status})
+				wantCode(t, err, m.want)
+			}
+
+			matchRes, err := st.SeedExchange().GetSeedMatch(context.Background(), match.Match.Id)
+			if err != nil {
+				t.Fatalf("GetSeedMatch: %v", err)
+			}
+			if matchRes.Status != tt.wantStatus {
+				t.Errorf("match status = %q, want %q", matchRes.Status, tt.wantStatus)
+			}
+			listingRes, err := st.SeedExchange().GetSeedListing(context.Background(), listing.Listing.Id)
+			if err != nil {
+				t.Fatalf("GetSeedListing: %v", err)
+			}
+			if listingRes.Status != tt.wantListing || listingRes.Available != tt.wantAvailable {
+				t.Errorf("listing is %s with %d available, want %s with %d", listingRes.Status, listingRes.Available, tt.wantListing, tt.wantAvailable)
+			}
+		})
+	}
+}
+
+func TestListSeedMatchesOnListing(t *testing.T) {
+	cs, _ := newTestService(t)
+	communityID := newTestCommunity(t, cs, "", aliceID, bobID, carolID)
+
+	listing, err := cs.CreateSeedListing(as(aliceID), &com.CreateSeedListingRequest{Listing: &com.SeedListing{
+		CommunityId: communityID,
+		Kind:        storage.SeedWant,
+		Species:     "Bean",
+		Quantity:    10,
+	}})
+	if err != nil {
+		t.Fatalf("CreateSeedListing: %v", err)
+	}
+	for _, claimant := range []string{bobID, carolID} {
+		if _, err := cs.ClaimSeedListing(as(claimant), &com.ClaimSeedListingRequest{ListingId: listing.Listing.Id, Quantity: 2}); err != nil {
+			t.Fatalf("ClaimSeedListing(%s): %v", claimant, err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		caller string
+		want   int
+	}{
+		{name: "listing owner sees every match", caller: aliceID, want: 2},
+		{name: "community owner moderates", caller: ownerID, want: 2},
+		{name: "claimant sees their own", caller: bobID, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := cs.ListSeedMatches(as(tt.caller), &com.ListSeedMatchesRequest{ListingId: listing.Listing.Id})
+			if err != nil {
+				t.Fatalf("ListSeedMatches: %v", err)
+			}
+			if len(res.Matches) != tt.want {
+				t.Errorf("got %d matches, want %d", len(res.Matches), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Kinds of repository failures. Use errors.Is to check which one an *Error carries.
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+	ErrInvalidInput = errors.New("invalid input")
+	ErrForeignKey   = errors.New("foreign key violation")
+)
+
+// Error is returned by the repositories so callers can branch on what went wrong
+// without parsing messages.
+type Error struct {
+	Kind     error  // one of ErrNotFound, ErrConflict, ErrInvalidInput or ErrForeignKey
+	Resource string // affected resource, e.g. "community" or "event"
+	Field    string // column or constraint that caused the failure, when known
+	Err      error  // underlying cause
+}
+
+func (e *Error) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("%s: %v", e.Resource, e.Kind)
+	}
+	return fmt.Sprintf("%s: %v: %v", e.Resource, e.Kind, e.Err)
+}
+
+func (e *Error) Unwrap() []error {
+	if e.Err == nil {
+		return []error{e.Kind}
+	}
+	return []error{e.Kind, e.Err}
+}
+
+// NewError builds an *Error of the given kind with a formatted cause.
+func NewError(kind error, resource, format string, args ...interface{}) error {
+	return &Error{Kind: kind, Resource: resource, Err: fmt.Errorf(format, args...)}
+}
@@ -0,0 +1,44 @@
+package storage
+
+import (
+	"strconv"
+	"time"
+)
+
+// DiffEvents lists the fields that differ between two versions of an event, with values
+// formatted as they appear in the API.
+func DiffEvents(old, updated *Event) []EventFieldChange {
+	changes := []EventFieldChange{}
+	add := func(field, oldValue, newValue string) {
+		if oldValue != newValue {
+			changes = append(changes, EventFieldChange{Field: field, OldValue: oldValue, NewValue: newValue})
+		}
+	}
+
+	add("name", old.Name, updated.Name)
+	add("description", old.Description, updated.Description)
+	add("event_type", old.EventType, updated.EventType)
+	add("start_time", old.StartTime.UTC().Format(time.RFC3339), updated.StartTime.UTC().Format(time.RFC3339))
+	add("end_time", old.EndTime.UTC().Format(time.RFC3339), updated.EndTime.UTC().Format(time.RFC3339))
+	add("location", old.Location, updated.Location)
+	add("latitude", formatFloat(old.Latitude), formatFloat(updated.Latitude))
+	add("longitude", formatFloat(old.Longitude), formatFloat(updated.Longitude))
+	add("capacity", formatInt(old.Capacity), formatInt(updated.Capacity))
+	add("recurrence_rule", old.RecurrenceRule, updated.RecurrenceRule)
+	add("garden_id", old.GardenID, updated.GardenID)
+	return changes
+}
+
+func formatFloat(v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*v, 'f', -1, 64)
+}
+
+func formatInt(v *int32) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatInt(int64(*v), 10)
+}
@@ -0,0 +1,19 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/Projects/ComunityService/storage"
+)
+
+func (s *Storage) RecordDenial(ctx context.Context, denial *storage.AuthorizationDenial) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	denial.ID = newID()
+	denial.CreatedAt = time.Now()
+	stored := *denial
+	s.denials = append(s.denials, &stored)
+	return nil
+}
@@ -0,0 +1,157 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/Projects/ComunityService/storage"
+)
+
+func (s *Storage) CreateCommunity(ctx context.Context, community *storage.Community, ownerID string) (*storage.Community, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	community.ID = newID()
+	community.CreatedAt = now
+	community.UpdatedAt = now
+	s.communities[community.ID] = &communityRecord{Community: *community}
+
+	s.members[memberKey{community.ID, ownerID}] = &memberRecord{CommunityMember: storage.CommunityMember{
+		CommunityID: community.ID,
+		UserID:      ownerID,
+		Role:        storage.RoleOwner,
+		JoinedAt:    now,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}}
+	s.history = append(s.history, &membershipPeriod{CommunityID: community.ID, UserID: ownerID, JoinedAt: now})
+
+	res := *community
+	return &res, nil
+}
+
+func (s *Storage) GetCommunity(ctx context.Context, comId string) (*storage.Community, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	c, ok := s.activeCommunity(comId)
+	if !ok {
+		return nil, storage.NewError(storage.ErrNotFound, "community", "community %s not found", comId)
+	}
+
+	res := c.Community
+	return &res, nil
+}
+
+func (s *Storage) UpdateCommunity(ctx context.Context, com *storage.CommunityUpdateFilter) (*storage.Community, error) {
+	if com.Name == nil && com.Description == nil && com.Location == nil && com.Latitude == nil && com.Longitude == nil && com.Visibility == nil {
+		return nil, storage.NewError(storage.ErrInvalidInput, "community", "no parameters to update")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.activeCommunity(*com.ID)
+	if !ok {
+		return nil, storage.NewError(storage.ErrNotFound, "community", "community %s not found", *com.ID)
+	}
+
+	if com.Name != nil {
+		c.Name = *com.Name
+	}
+	if com.Description != nil {
+		c.Description = *com.Description
+	}
+	if com.Location != nil {
+		c.Location = *com.Location
+	}
+	if com.Latitude != nil {
+		lat := *com.Latitude
+		c.Latitude = &lat
+	}
+	if com.Longitude != nil {
+		lng := *com.Longitude
+		c.Longitude = &lng
+	}
+	if com.Visibility != nil {
+		c.Visibility = *com.Visibility
+	}
+	c.UpdatedAt = time.Now()
+
+	res := c.Community
+	return &res, nil
+}
+
+// GetAllCommunities returns communities in (created_at, id) order, starting after
+// comFilter.After when it is set.
+func (s *Storage) GetAllCommunities(ctx context.Context, comFilter *storage.CommunityGetFilter) ([]*storage.Community, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	communities := []*storage.Community{}
+	for _, c := range s.matchingCommunities(comFilter) {
+		if after := comFilter.After; after != nil {
+			if c.CreatedAt.Before(after.CreatedAt) || (c.CreatedAt.Equal(after.CreatedAt) && c.ID <= after.ID) {
+				continue
+			}
+		}
+		communities = append(communities, c)
+	}
+
+	var limit, offset int32
+	if comFilter.Limit != nil {
+		limit = *comFilter.Limit
+	}
+	if comFilter.Offset != nil {
+		offset = *comFilter.Offset
+	}
+	start, end := page(len(communities), limit, offset)
+	return communities[start:end], nil
+}
+
+func (s *Storage) CountCommunities(ctx context.Context, comFilter *storage.CommunityGetFilter) (int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return int64(len(s.matchingCommunities(comFilter))), nil
+}
+
+// matchingCommunities returns copies of the active communities matching the name,
+// location and visibility filters, sorted by (created_at, id). Callers must hold s.mu.
+func (s *Storage) matchingCommunities(comFilter *storage.CommunityGetFilter) []*storage.Community {
+	communities := []*storage.Community{}
+	for _, c := range s.communities {
+		if c.DeletedAt != nil {
+			continue
+		}
+		if comFilter.Name != nil && c.Name != *comFilter.Name {
+			continue
+		}
+		if comFilter.Location != nil && c.Location != *comFilter.Location {
+			continue
+		}
+		if !s.visibleTo(c, comFilter.VisibleTo) {
+			continue
+		}
+		res := c.Community
+		communities = append(communities, &res)
+	}
+
+	sort.Slice(communities, func(i, j int) bool {
+		if communities[i].CreatedAt.Equal(communities[j].CreatedAt) {
+			return communities[i].ID < communities[j].ID
+		}
+		return communities[i].CreatedAt.Before(communities[j].CreatedAt)
+	})
+	return communities
+}
+
+func (s *Storage) IsValidCommunity(ctx context.Context, comId string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.activeCommunity(comId)
+	return ok, nil
+}
@@ -0,0 +1,148 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Projects/ComunityService/storage"
+)
+
+func (s *Storage) CreateJoinRequest(ctx context.Context, request *storage.JoinRequest) (*storage.JoinRequest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.communities[request.CommunityID]; !ok {
+		return nil, storage.NewError(storage.ErrForeignKey, "join request", "community %s does not exist", request.CommunityID)
+	}
+	if _, ok := s.activeMember(request.CommunityID, request.UserID); ok {
+		return nil, storage.NewError(storage.ErrConflict, "join request", "user %s is already a member of community %s", request.UserID, request.CommunityID)
+	}
+	for _, r := range s.requests {
+		if r.CommunityID == request.CommunityID && r.UserID == request.UserID && r.Status == storage.JoinRequestPending {
+			return nil, storage.NewError(storage.ErrConflict, "join request", "user %s already asked to join community %s", request.UserID, request.CommunityID)
+		}
+	}
+
+	now := time.Now()
+	stored := &storage.JoinRequest{
+		ID:          newID(),
+		CommunityID: request.CommunityID,
+		UserID:      request.UserID,
+		Status:      storage.JoinRequestPending,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	s.requests[stored.ID] = stored
+
+	res := *stored
+	return &res, nil
+}
+
+func (s *Storage) GetJoinRequest(ctx context.Context, requestID string) (*storage.JoinRequest, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	r, ok := s.requests[requestID]
+	if !ok {
+		return nil, storage.NewError(storage.ErrNotFound, "join request", "join request %s not found", requestID)
+	}
+
+	res := *r
+	return &res, nil
+}
+
+// ListJoinRequests returns the community's requests oldest first.
+func (s *Storage) ListJoinRequests(ctx context.Context, filter *storage.JoinRequestFilter) ([]*storage.JoinRequest, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	requests := []*storage.JoinRequest{}
+	for _, r := range s.requests {
+		if r.CommunityID != filter.CommunityID || r.Status != filter.Status {
+			continue
+		}
+		res := *r
+		requests = append(requests, &res)
+	}
+
+	sort.Slice(requests, func(i, j int) bool {
+		if requests[i].CreatedAt.Equal(requests[j].CreatedAt) {
+			return requests[i].ID < requests[j].ID
+		}
+		return requests[i].CreatedAt.Before(requests[j].CreatedAt)
+	})
+
+	start, end := page(len(requests), filter.Limit, filter.Offset)
+	return requests[start:end], nil
+}
+
+func (s *Storage) DecideJoinRequest(ctx context.Context, requestID, status, decidedBy string) (*storage.JoinRequest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.requests[requestID]
+	if !ok {
+		return nil, storage.NewError(storage.ErrNotFound, "join request", "join request %s not found", requestID)
+	}
+	if r.Status != storage.JoinRequestPending {
+		return nil, storage.NewError(storage.ErrConflict, "join request", "join request %s is no longer pending", requestID)
+	}
+
+	if status == storage.JoinRequestApproved {
+		if _, err := s.join(r.CommunityID, r.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	r.Status = status
+	r.DecidedBy = decidedBy
+	r.DecidedAt = &now
+	r.UpdatedAt = now
+
+	res := *r
+	return &res, nil
+}
+
+func (s *Storage) CreateInvite(ctx context.Context, invite *storage.CommunityInvite) (*storage.CommunityInvite, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.communities[invite.CommunityID]; !ok {
+		return nil, storage.NewError(storage.ErrForeignKey, "community invite", "community %s does not exist", invite.CommunityID)
+	}
+
+	stored := *invite
+	stored.ID = newID()
+	stored.Uses = 0
+	stored.CreatedAt = time.Now()
+	s.invites[stored.ID] = &stored
+
+	res := stored
+	return &res, nil
+}
+
+func (s *Storage) RedeemInvite(ctx context.Context, inviteID, communityID, userID string) (*storage.JoinCommunity, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	invite, ok := s.invites[inviteID]
+	if !ok || invite.CommunityID != communityID {
+		return nil, storage.NewError(storage.ErrNotFound, "community invite", "invite %s not found", inviteID)
+	}
+	if !time.Now().Before(invite.ExpiresAt) {
+		return nil, &storage.Error{Kind: storage.ErrInvalidInput, Resource: "community invite", Field: "invite_code", Err: fmt.Errorf("invite %s has expired", inviteID)}
+	}
+	if invite.Uses >= invite.MaxUses {
+		return nil, &storage.Error{Kind: storage.ErrInvalidInput, Resource: "community invite", Field: "invite_code", Err: fmt.Errorf("invite %s has been used up", inviteID)}
+	}
+
+	jCom, err := s.join(communityID, userID)
+	if err != nil {
+		return nil, err
+	}
+	invite.Uses++
+	return jCom, nil
+}
@@ -0,0 +1,125 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/Projects/ComunityService/storage"
+)
+
+// DeleteCommunity stamps everything the cascade closes with the community's deletion time,
+// so that RestoreCommunity can tell it apart from what was closed before.
+func (s *Storage) DeleteCommunity(ctx context.Context, comId, deletedBy string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.activeCommunity(comId)
+	if !ok {
+		return storage.NewError(storage.ErrNotFound, "community", "community %s not found", comId)
+	}
+
+	now := time.Now()
+	c.DeletedAt = &now
+	c.UpdatedAt = now
+
+	for _, m := range s.members {
+		if m.CommunityID == comId && m.DeletedAt == nil {
+			m.DeletedAt = &now
+			m.UpdatedAt = now
+		}
+	}
+	for _, p := range s.history {
+		if p.CommunityID == comId && p.LeftAt == nil {
+			p.LeftAt = &now
+		}
+	}
+	for _, e := range s.events {
+		if e.CommunityID != comId || e.DeletedAt != nil {
+			continue
+		}
+		if !e.StartTime.After(now) && e.RecurrenceRule == "" {
+			continue
+		}
+		e.DeletedAt = &now
+		e.UpdatedAt = now
+		e.CancellationReason = storage.CommunityDeletedReason
+		s.logEventChange(&storage.EventChange{
+			EventID:    e.ID,
+			ChangedBy:  deletedBy,
+			ChangeType: storage.EventChangeCancelled,
+			Changes:    []storage.EventFieldChange{},
+			Reason:     storage.CommunityDeletedReason,
+		})
+	}
+	for _, p := range s.posts {
+		if p.CommunityID == comId && p.DeletedAt == nil && p.ArchivedAt == nil {
+			p.ArchivedAt = &now
+		}
+	}
+	return nil
+}
+
+func (s *Storage) GetDeletedCommunity(ctx context.Context, comId string) (*storage.DeletedCommunity, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	c, ok := s.communities[comId]
+	if !ok || c.DeletedAt == nil {
+		return nil, storage.NewError(storage.ErrNotFound, "community", "community %s not found", comId)
+	}
+
+	res := &storage.DeletedCommunity{Community: c.Community, DeletedAt: *c.DeletedAt}
+	for _, m := range s.members {
+		// The owner is the one whose membership ended with the community.
+		if m.CommunityID == comId && m.Role == storage.RoleOwner && m.DeletedAt != nil && m.DeletedAt.Equal(*c.DeletedAt) {
+			res.OwnerID = m.UserID
+		}
+	}
+	return res, nil
+}
+
+func (s *Storage) RestoreCommunity(ctx context.Context, comId, restoredBy string) (*storage.Community, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.communities[comId]
+	if !ok || c.DeletedAt == nil {
+		return nil, storage.NewError(storage.ErrNotFound, "community", "community %s not found", comId)
+	}
+
+	deletedAt := *c.DeletedAt
+	now := time.Now()
+	c.DeletedAt = nil
+	c.UpdatedAt = now
+
+	for _, m := range s.members {
+		if m.CommunityID == comId && m.DeletedAt != nil && m.DeletedAt.Equal(deletedAt) {
+			m.DeletedAt = nil
+			m.UpdatedAt = now
+			s.history = append(s.history, &membershipPeriod{CommunityID: comId, UserID: m.UserID, JoinedAt: now})
+		}
+	}
+	for _, e := range s.events {
+		if e.CommunityID != comId || e.DeletedAt == nil || !e.DeletedAt.Equal(deletedAt) || e.CancellationReason != storage.CommunityDeletedReason {
+			continue
+		}
+		e.DeletedAt = nil
+		e.UpdatedAt = now
+		e.CancellationReason = ""
+		s.logEventChange(&storage.EventChange{
+			EventID:    e.ID,
+			ChangedBy:  restoredBy,
+			ChangeType: storage.EventChangeRestored,
+			Changes:    []storage.EventFieldChange{},
+			Reason:     storage.CommunityDeletedReason,
+		})
+	}
+	for _, p := range s.posts {
+		if p.CommunityID == comId && p.ArchivedAt != nil && p.ArchivedAt.Equal(deletedAt) {
+			p.ArchivedAt = nil
+		}
+	}
+
+	res := c.Community
+	return &res, nil
+}
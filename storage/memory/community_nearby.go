@@ -0,0 +1,45 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Projects/ComunityService/storage"
+)
+
+// FindCommunitiesNearby returns communities within filter.RadiusKm of the given point,
+// nearest first, using the same bounding-box prefilter and haversine distance as the
+// Postgres fallback.
+func (s *Storage) FindCommunitiesNearby(ctx context.Context, filter *storage.NearbyFilter) ([]*storage.NearbyCommunity, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	minLat, maxLat, minLng, maxLng := storage.BoundingBox(filter.Latitude, filter.Longitude, filter.RadiusKm)
+
+	results := []*storage.NearbyCommunity{}
+	for _, c := range s.communities {
+		if c.DeletedAt != nil || c.Latitude == nil || c.Longitude == nil || !s.visibleTo(c, filter.VisibleTo) {
+			continue
+		}
+		lat, lng := *c.Latitude, *c.Longitude
+		if lat < minLat || lat > maxLat || lng < minLng || lng > maxLng {
+			continue
+		}
+
+		distance := storage.HaversineKm(filter.Latitude, filter.Longitude, lat, lng)
+		if distance > filter.RadiusKm {
+			continue
+		}
+		results = append(results, &storage.NearbyCommunity{Community: c.Community, DistanceKm: distance})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].DistanceKm == results[j].DistanceKm {
+			return results[i].Community.ID < results[j].Community.ID
+		}
+		return results[i].DistanceKm < results[j].DistanceKm
+	})
+
+	start, end := page(len(results), filter.Limit, 0)
+	return results[start:end], nil
+}
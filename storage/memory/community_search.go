@@ -0,0 +1,164 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/Projects/ComunityService/storage"
+)
+
+// snippetWords matches the MaxWords option used by ts_headline in the postgres search.
+const snippetWords = 35
+
+// SearchCommunities approximates the postgres full-text search: name matches outrank
+// description matches, and name words one or two edits away from a term still count.
+func (s *Storage) SearchCommunities(ctx context.Context, filter *storage.CommunitySearchFilter) ([]*storage.CommunitySearchResult, error) {
+	terms := searchWords(filter.Query)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	results := []*storage.CommunitySearchResult{}
+	if len(terms) == 0 {
+		return results, nil
+	}
+
+	for _, c := range s.communities {
+		if c.DeletedAt != nil || !s.visibleTo(c, filter.VisibleTo) {
+			continue
+		}
+
+		nameWords := searchWords(c.Name)
+		descWords := searchWords(c.Description)
+
+		var rank float64
+		for _, term := range terms {
+			switch {
+			case matchesAny(nameWords, term):
+				rank += 1
+			case fuzzyMatchesAny(nameWords, term):
+				rank += 0.5
+			}
+			if matchesAny(descWords, term) {
+				rank += 0.4
+			}
+		}
+		if rank == 0 {
+			continue
+		}
+
+		results = append(results, &storage.CommunitySearchResult{
+			Community:     c.Community,
+			Rank:          rank,
+			NameHighlight: highlight(strings.Fields(c.Name), terms),
+			Snippet:       snippet(c.Description, terms),
+		})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Rank == results[j].Rank {
+			return results[i].Community.ID < results[j].Community.ID
+		}
+		return results[i].Rank > results[j].Rank
+	})
+
+	start, end := page(len(results), filter.Limit, filter.Offset)
+	return results[start:end], nil
+}
+
+func searchWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// wordMatches treats a term as matching any word it is a prefix of, which is close enough
+// to stemming for words like "garden" and "gardening".
+func wordMatches(word, term string) bool {
+	return strings.HasPrefix(word, term)
+}
+
+func matchesAny(words []string, term string) bool {
+	for _, w := range words {
+		if wordMatches(w, term) {
+			return true
+		}
+	}
+	return false
+}
+
+func fuzzyMatchesAny(words []string, term string) bool {
+	maxEdits := 1
+	if len(term) >= 8 {
+		maxEdits = 2
+	}
+	if len(term) < 4 {
+		return false
+	}
+	for _, w := range words {
+		if levenshtein(w, term) <= maxEdits {
+			return true
+		}
+	}
+	return false
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
+
+// matchesTerms reports whether a raw word from the text matches any search term.
+func matchesTerms(word string, terms []string) bool {
+	normalized := searchWords(word)
+	for _, term := range terms {
+		if matchesAny(normalized, term) {
+			return true
+		}
+	}
+	return false
+}
+
+// highlight wraps the words matching a term in <b></b>, like ts_headline does.
+func highlight(words []string, terms []string) string {
+	out := make([]string, len(words))
+	for i, w := range words {
+		out[i] = w
+		if matchesTerms(w, terms) {
+			out[i] = "<b>" + w + "</b>"
+		}
+	}
+	return strings.Join(out, " ")
+}
+
+// snippet returns a highlighted window of the text around its first matching word.
+func snippet(text string, terms []string) string {
+	words := strings.Fields(text)
+	start := 0
+	for i, w := range words {
+		if matchesTerms(w, terms) {
+			start = max(0, i-5)
+			break
+		}
+	}
+	end := min(len(words), start+snippetWords)
+	return highlight(words[start:end], terms)
+}
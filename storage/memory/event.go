@@ -0,0 +1,137 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/Projects/ComunityService/storage"
+)
+
+func (s *Storage) CreateCommunityEvent(ctx context.Context, event *storage.Event) (*storage.Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.communities[event.CommunityID]; !ok {
+		return nil, storage.NewError(storage.ErrForeignKey, "event", "community %s does not exist", event.CommunityID)
+	}
+
+	now := time.Now()
+	event.ID = newID()
+	event.CreatedAt = now
+	event.UpdatedAt = now
+	s.events[event.ID] = &eventRecord{Event: *event}
+
+	res := *event
+	return &res, nil
+}
+
+func (s *Storage) GetCommunityEvent(ctx context.Context, eventID string) (*storage.Event, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	e, ok := s.events[eventID]
+	if !ok || e.DeletedAt != nil {
+		return nil, storage.NewError(storage.ErrNotFound, "event", "event %s not found", eventID)
+	}
+
+	res := e.Event
+	return &res, nil
+}
+
+func (s *Storage) ListCalendarEvents(ctx context.Context, communityID string, since time.Time) ([]*storage.Event, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	events := []*storage.Event{}
+	for _, e := range s.events {
+		if e.CommunityID != communityID || (e.RecurrenceRule == "" && e.EndTime.Before(since)) {
+			continue
+		}
+		res := e.Event
+		res.DeletedAt = e.DeletedAt
+		events = append(events, &res)
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].StartTime.Equal(events[j].StartTime) {
+			return events[i].ID < events[j].ID
+		}
+		return events[i].StartTime.Before(events[j].StartTime)
+	})
+
+	return events, nil
+}
+
+// ListEvents returns active events in (start_time, id) order, starting after filter.After
+// when it is set.
+func (s *Storage) ListEvents(ctx context.Context, filter *storage.EventListFilter) ([]*storage.Event, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	types := map[string]bool{}
+	for _, t := range filter.Types {
+		types[t] = true
+	}
+
+	events := []*storage.Event{}
+	for _, e := range s.events {
+		if e.DeletedAt != nil || !s.eventMatches(&e.Event, filter, types) {
+			continue
+		}
+		res := e.Event
+		events = append(events, &res)
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].StartTime.Equal(events[j].StartTime) {
+			return events[i].ID < events[j].ID
+		}
+		return events[i].StartTime.Before(events[j].StartTime)
+	})
+
+	start, end := page(len(events), filter.Limit, 0)
+	return events[start:end], nil
+}
+
+// eventMatches applies the conditions of ListEvents to a single event. Callers must hold s.mu.
+func (s *Storage) eventMatches(e *storage.Event, filter *storage.EventListFilter, types map[string]bool) bool {
+	if filter.CommunityID != "" && e.CommunityID != filter.CommunityID {
+		return false
+	}
+	if filter.MemberID != "" {
+		if _, ok := s.activeCommunity(e.CommunityID); !ok {
+			return false
+		}
+		if _, ok := s.activeMember(e.CommunityID, filter.MemberID); !ok {
+			return false
+		}
+	}
+	if len(types) > 0 && !types[e.EventType] {
+		return false
+	}
+	if filter.To != nil && e.StartTime.After(*filter.To) {
+		return false
+	}
+	if filter.EndsBefore != nil && !e.StartTime.Before(*filter.EndsBefore) {
+		return false
+	}
+
+	// Conditions on a single occurrence only apply to one-off events.
+	if e.RecurrenceRule == "" {
+		if filter.From != nil && e.EndTime.Before(*filter.From) {
+			return false
+		}
+		if filter.StartsAfter != nil && e.StartTime.Before(*filter.StartsAfter) {
+			return false
+		}
+		if filter.EndsBefore != nil && !e.EndTime.Before(*filter.EndsBefore) {
+			return false
+		}
+	}
+
+	if after := filter.After; after != nil {
+		if e.StartTime.Before(after.StartTime) || (e.StartTime.Equal(after.StartTime) && e.ID <= after.ID) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,166 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/Projects/ComunityService/storage"
+)
+
+func (s *Storage) UpdateCommunityEvent(ctx context.Context, update *storage.EventUpdateFilter, changedBy string) (*storage.Event, error) {
+	if update.Name == nil && update.Description == nil && update.EventType == nil && update.StartTime == nil &&
+		update.EndTime == nil && update.Location == nil && update.Latitude == nil && update.Longitude == nil &&
+		!update.ClearCoordinates && update.Capacity == nil && update.RecurrenceRule == nil && update.GardenID == nil {
+		return nil, storage.NewError(storage.ErrInvalidInput, "event", "no parameters to update")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.events[*update.ID]
+	if !ok || e.DeletedAt != nil {
+		return nil, storage.NewError(storage.ErrNotFound, "event", "event %s not found", *update.ID)
+	}
+	old := e.Event
+
+	if update.Name != nil {
+		e.Name = *update.Name
+	}
+	if update.Description != nil {
+		e.Description = *update.Description
+	}
+	if update.EventType != nil {
+		e.EventType = *update.EventType
+	}
+	if update.StartTime != nil {
+		e.StartTime = *update.StartTime
+	}
+	if update.EndTime != nil {
+		e.EndTime = *update.EndTime
+	}
+	if update.Location != nil {
+		e.Location = *update.Location
+	}
+	if update.ClearCoordinates {
+		e.Latitude, e.Longitude = nil, nil
+	} else {
+		if update.Latitude != nil {
+			lat := *update.Latitude
+			e.Latitude = &lat
+		}
+		if update.Longitude != nil {
+			lng := *update.Longitude
+			e.Longitude = &lng
+		}
+	}
+	if update.Capacity != nil {
+		e.Capacity = nil
+		if *update.Capacity != 0 {
+			capacity := *update.Capacity
+			e.Capacity = &capacity
+		}
+	}
+	if update.RecurrenceRule != nil {
+		e.RecurrenceRule = *update.RecurrenceRule
+	}
+	if update.GardenID != nil {
+		e.GardenID = *update.GardenID
+	}
+	e.UpdatedAt = time.Now()
+
+	if changes := storage.DiffEvents(&old, &e.Event); len(changes) > 0 {
+		s.logEventChange(&storage.EventChange{
+			EventID:    e.ID,
+			ChangedBy:  changedBy,
+			ChangeType: storage.EventChangeUpdated,
+			Changes:    changes,
+		})
+	}
+
+	// A larger capacity frees seats for the waitlist, and no capacity frees them all.
+	if update.Capacity != nil && e.Capacity == nil {
+		now := time.Now()
+		for _, r := range s.rsvps {
+			if r.EventID == e.ID && r.Status == storage.RsvpWaitlisted {
+				r.Status = storage.RsvpGoing
+				r.UpdatedAt = now
+			}
+		}
+	} else if update.Capacity != nil {
+		for s.promoteWaitlisted(e) != nil {
+			// Keep promoting until the event is full or the waitlist is empty.
+		}
+	}
+
+	res := e.Event
+	return &res, nil
+}
+
+func (s *Storage) DeleteCommunityEvent(ctx context.Context, eventID, changedBy, reason string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.events[eventID]
+	if !ok || e.DeletedAt != nil {
+		return storage.NewError(storage.ErrNotFound, "event", "event %s not found", eventID)
+	}
+
+	now := time.Now()
+	e.DeletedAt = &now
+	e.UpdatedAt = now
+	e.CancellationReason = reason
+
+	s.logEventChange(&storage.EventChange{
+		EventID:    eventID,
+		ChangedBy:  changedBy,
+		ChangeType: storage.EventChangeCancelled,
+		Changes:    []storage.EventFieldChange{},
+		Reason:     reason,
+	})
+	return nil
+}
+
+func (s *Storage) GetEventWithCancelled(ctx context.Context, eventID string) (*storage.Event, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	e, ok := s.events[eventID]
+	if !ok {
+		return nil, storage.NewError(storage.ErrNotFound, "event", "event %s not found", eventID)
+	}
+
+	res := e.Event
+	if e.DeletedAt != nil {
+		deletedAt := *e.DeletedAt
+		res.DeletedAt = &deletedAt
+	}
+	return &res, nil
+}
+
+// ListEventChanges returns the change log of an event, newest first.
+func (s *Storage) ListEventChanges(ctx context.Context, eventID string, limit, offset int32) ([]*storage.EventChange, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	changes := []*storage.EventChange{}
+	for _, c := range s.changes {
+		if c.EventID == eventID {
+			res := *c
+			changes = append(changes, &res)
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].CreatedAt.After(changes[j].CreatedAt)
+	})
+
+	start, end := page(len(changes), limit, offset)
+	return changes[start:end], nil
+}
+
+// logEventChange appends to the change log. Callers must hold s.mu for writing.
+func (s *Storage) logEventChange(change *storage.EventChange) {
+	change.ID = newID()
+	change.CreatedAt = time.Now()
+	s.changes = append(s.changes, change)
+}
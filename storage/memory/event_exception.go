@@ -0,0 +1,49 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/Projects/ComunityService/storage"
+)
+
+func (s *Storage) SetEventException(ctx context.Context, exception *storage.EventException) (*storage.EventException, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.events[exception.EventID]; !ok {
+		return nil, storage.NewError(storage.ErrForeignKey, "event exception", "event %s does not exist", exception.EventID)
+	}
+
+	now := time.Now()
+	key := exceptionKey{exception.EventID, exception.OriginalStart.Unix()}
+	res := *exception
+	res.CreatedAt = now
+	if existing, ok := s.exceptions[key]; ok {
+		res.CreatedAt = existing.CreatedAt
+	}
+	res.UpdatedAt = now
+	s.exceptions[key] = &res
+
+	out := res
+	return &out, nil
+}
+
+func (s *Storage) ListEventExceptions(ctx context.Context, eventID string) ([]*storage.EventException, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	exceptions := []*storage.EventException{}
+	for _, ex := range s.exceptions {
+		if ex.EventID == eventID {
+			res := *ex
+			exceptions = append(exceptions, &res)
+		}
+	}
+	sort.Slice(exceptions, func(i, j int) bool {
+		return exceptions[i].OriginalStart.Before(exceptions[j].OriginalStart)
+	})
+
+	return exceptions, nil
+}
@@ -0,0 +1,67 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/Projects/ComunityService/storage"
+)
+
+func (s *Storage) RecordEventPlanting(ctx context.Context, planting *storage.EventPlanting) (*storage.EventPlanting, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.events[planting.EventID]; !ok {
+		return nil, storage.NewError(storage.ErrForeignKey, "event planting", "event %s does not exist", planting.EventID)
+	}
+
+	planting.ID = newID()
+	planting.PlantID = ""
+	planting.CreatedAt = time.Now()
+	stored := *planting
+	s.plantings = append(s.plantings, &stored)
+
+	res := *planting
+	return &res, nil
+}
+
+func (s *Storage) CompleteEventPlanting(ctx context.Context, plantingID, plantID string) (*storage.EventPlanting, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, p := range s.plantings {
+		if p.ID == plantingID && p.PlantID == "" {
+			p.PlantID = plantID
+			res := *p
+			return &res, nil
+		}
+	}
+	return nil, storage.NewError(storage.ErrNotFound, "event planting", "pending event planting %s not found", plantingID)
+}
+
+func (s *Storage) DeletePendingEventPlanting(ctx context.Context, plantingID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, p := range s.plantings {
+		if p.ID == plantingID && p.PlantID == "" {
+			s.plantings = append(s.plantings[:i], s.plantings[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func (s *Storage) ListEventPlantings(ctx context.Context, eventID string) ([]*storage.EventPlanting, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	plantings := []*storage.EventPlanting{}
+	for _, p := range s.plantings {
+		if p.EventID == eventID {
+			res := *p
+			plantings = append(plantings, &res)
+		}
+	}
+	return plantings, nil
+}
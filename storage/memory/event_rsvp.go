@@ -0,0 +1,146 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/Projects/ComunityService/storage"
+)
+
+// rsvpOrder sorts statuses the way the rsvp_status enum does in Postgres.
+var rsvpOrder = map[string]int{
+	storage.RsvpGoing:      0,
+	storage.RsvpMaybe:      1,
+	storage.RsvpDeclined:   2,
+	storage.RsvpWaitlisted: 3,
+}
+
+func (s *Storage) RsvpEvent(ctx context.Context, rsvp *storage.EventRsvp) (*storage.EventRsvp, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.events[rsvp.EventID]
+	if !ok || e.DeletedAt != nil {
+		return nil, storage.NewError(storage.ErrNotFound, "event", "event %s not found", rsvp.EventID)
+	}
+
+	key := rsvpKey{rsvp.EventID, rsvp.UserID}
+	existing := s.rsvps[key]
+	var previous string
+	if existing != nil {
+		previous = existing.Status
+	}
+
+	status := rsvp.Status
+	if status == storage.RsvpGoing && previous != storage.RsvpGoing && e.Capacity != nil && s.countGoing(rsvp.EventID) >= *e.Capacity {
+		status = storage.RsvpWaitlisted
+	}
+
+	now := time.Now()
+	if existing == nil {
+		existing = &storage.EventRsvp{EventID: rsvp.EventID, UserID: rsvp.UserID, CreatedAt: now, UpdatedAt: now}
+		s.rsvps[key] = existing
+	} else if existing.Status != status {
+		// Asking for a seat again while still waitlisted keeps the place in the queue.
+		existing.UpdatedAt = now
+	}
+	existing.Status = status
+
+	if previous == storage.RsvpGoing && status != storage.RsvpGoing {
+		s.promoteWaitlisted(e)
+	}
+
+	res := *existing
+	return &res, nil
+}
+
+func (s *Storage) CancelRsvp(ctx context.Context, eventID, userID string) (*storage.EventRsvp, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.events[eventID]
+	if !ok || e.DeletedAt != nil {
+		return nil, storage.NewError(storage.ErrNotFound, "event", "event %s not found", eventID)
+	}
+
+	key := rsvpKey{eventID, userID}
+	existing, ok := s.rsvps[key]
+	if !ok {
+		return nil, storage.NewError(storage.ErrNotFound, "event rsvp", "user %s has not responded to event %s", userID, eventID)
+	}
+	delete(s.rsvps, key)
+
+	if existing.Status != storage.RsvpGoing {
+		return nil, nil
+	}
+	return s.promoteWaitlisted(e), nil
+}
+
+func (s *Storage) ListEventAttendees(ctx context.Context, filter *storage.AttendeeListFilter) ([]*storage.EventRsvp, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	attendees := []*storage.EventRsvp{}
+	for _, r := range s.rsvps {
+		if r.EventID != filter.EventID || (filter.Status != "" && r.Status != filter.Status) {
+			continue
+		}
+		res := *r
+		attendees = append(attendees, &res)
+	}
+	sortRsvps(attendees)
+
+	start, end := page(len(attendees), filter.Limit, filter.Offset)
+	return attendees[start:end], nil
+}
+
+// countGoing returns the number of going RSVPs for the event. Callers must hold s.mu.
+func (s *Storage) countGoing(eventID string) int32 {
+	var going int32
+	for _, r := range s.rsvps {
+		if r.EventID == eventID && r.Status == storage.RsvpGoing {
+			going++
+		}
+	}
+	return going
+}
+
+// promoteWaitlisted moves the longest-waiting RSVP to going if a seat is free and returns
+// a copy of it, or nil when nobody was promoted. Callers must hold s.mu for writing.
+func (s *Storage) promoteWaitlisted(e *eventRecord) *storage.EventRsvp {
+	if e.Capacity == nil || s.countGoing(e.ID) >= *e.Capacity {
+		return nil
+	}
+
+	waitlist := []*storage.EventRsvp{}
+	for _, r := range s.rsvps {
+		if r.EventID == e.ID && r.Status == storage.RsvpWaitlisted {
+			waitlist = append(waitlist, r)
+		}
+	}
+	if len(waitlist) == 0 {
+		return nil
+	}
+	sortRsvps(waitlist)
+
+	next := waitlist[0]
+	next.Status = storage.RsvpGoing
+	next.UpdatedAt = time.Now()
+
+	res := *next
+	return &res
+}
+
+func sortRsvps(rsvps []*storage.EventRsvp) {
+	sort.Slice(rsvps, func(i, j int) bool {
+		a, b := rsvps[i], rsvps[j]
+		if a.Status != b.Status {
+			return rsvpOrder[a.Status] < rsvpOrder[b.Status]
+		}
+		if !a.UpdatedAt.Equal(b.UpdatedAt) {
+			return a.UpdatedAt.Before(b.UpdatedAt)
+		}
+		return a.UserID < b.UserID
+	})
+}
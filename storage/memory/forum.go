@@ -0,0 +1,80 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/Projects/ComunityService/storage"
+)
+
+func (s *Storage) CreateForumPost(ctx context.Context, post *storage.ForumPost) (*storage.ForumPost, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.communities[post.CommunityID]; !ok {
+		return nil, storage.NewError(storage.ErrForeignKey, "forum post", "community %s does not exist", post.CommunityID)
+	}
+
+	now := time.Now()
+	post.ID = newID()
+	post.CreatedAt = now
+	post.UpdatedAt = now
+	s.posts[post.ID] = &forumPostRecord{ForumPost: *post}
+
+	res := *post
+	return &res, nil
+}
+
+func (s *Storage) GetForumPost(ctx context.Context, postID string) (*storage.ForumPost, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	p, ok := s.posts[postID]
+	if !ok || p.DeletedAt != nil {
+		return nil, storage.NewError(storage.ErrNotFound, "forum post", "forum post %s not found", postID)
+	}
+
+	res := p.ForumPost
+	return &res, nil
+}
+
+func (s *Storage) CreateForumComment(ctx context.Context, comment *storage.ForumComment) (*storage.ForumComment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.posts[comment.ForumID]; !ok {
+		return nil, storage.NewError(storage.ErrForeignKey, "forum comment", "forum post %s does not exist", comment.ForumID)
+	}
+
+	now := time.Now()
+	comment.ID = newID()
+	comment.CreatedAt = now
+	comment.UpdatedAt = now
+	s.comments[comment.ID] = &forumCommentRecord{ForumComment: *comment}
+
+	res := *comment
+	return &res, nil
+}
+
+func (s *Storage) GetForumComments(ctx context.Context, postID string) ([]*storage.ForumComment, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	comments := []*storage.ForumComment{}
+	for _, c := range s.comments {
+		if c.ForumID != postID || c.DeletedAt != nil {
+			continue
+		}
+		res := c.ForumComment
+		comments = append(comments, &res)
+	}
+
+	sort.Slice(comments, func(i, j int) bool {
+		if comments[i].CreatedAt.Equal(comments[j].CreatedAt) {
+			return comments[i].ID < comments[j].ID
+		}
+		return comments[i].CreatedAt.Before(comments[j].CreatedAt)
+	})
+	return comments, nil
+}
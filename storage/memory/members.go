@@ -0,0 +1,214 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Projects/ComunityService/storage"
+)
+
+func (s *Storage) JoinCommunity(ctx context.Context, jCom *storage.JoinCommunity) (*storage.JoinCommunity, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.join(jCom.CommunityID, jCom.UserID)
+}
+
+// join adds the membership and opens its history period. Callers must hold s.mu.
+func (s *Storage) join(communityID, userID string) (*storage.JoinCommunity, error) {
+	if _, ok := s.communities[communityID]; !ok {
+		return nil, storage.NewError(storage.ErrForeignKey, "community member", "community %s does not exist", communityID)
+	}
+
+	now := time.Now()
+	key := memberKey{communityID, userID}
+	m, ok := s.members[key]
+	switch {
+	case ok && m.DeletedAt == nil:
+		return nil, storage.NewError(storage.ErrConflict, "community member", "user %s is already a member of community %s", userID, communityID)
+	case ok:
+		m.Role = storage.RoleMember
+		m.JoinedAt = now
+		m.UpdatedAt = now
+		m.DeletedAt = nil
+	default:
+		m = &memberRecord{CommunityMember: storage.CommunityMember{
+			CommunityID: communityID,
+			UserID:      userID,
+			Role:        storage.RoleMember,
+			JoinedAt:    now,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}}
+		s.members[key] = m
+	}
+	s.history = append(s.history, &membershipPeriod{CommunityID: communityID, UserID: userID, JoinedAt: now})
+
+	return &storage.JoinCommunity{
+		CommunityID: m.CommunityID,
+		UserID:      m.UserID,
+		JoinedAt:    m.JoinedAt.Format(time.RFC3339Nano),
+		CreatedAt:   m.CreatedAt.Format(time.RFC3339Nano),
+		UpdatedAt:   m.UpdatedAt.Format(time.RFC3339Nano),
+	}, nil
+}
+
+func (s *Storage) LeaveCommunity(ctx context.Context, lCom *storage.LeaveCommunity) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.leave(lCom.CommunityId, lCom.UserID)
+}
+
+// leave ends the active membership and closes its history period. The owner never
+// leaves this way. Callers must hold s.mu.
+func (s *Storage) leave(communityID, userID string) error {
+	m, ok := s.activeMember(communityID, userID)
+	if !ok || m.Role == storage.RoleOwner {
+		return storage.NewError(storage.ErrNotFound, "community member", "no member %s who can leave community %s", userID, communityID)
+	}
+
+	now := time.Now()
+	m.DeletedAt = &now
+	m.UpdatedAt = now
+	for _, p := range s.history {
+		if p.CommunityID == communityID && p.UserID == userID && p.LeftAt == nil {
+			p.LeftAt = &now
+		}
+	}
+	return nil
+}
+
+func (s *Storage) IsCommunityMember(ctx context.Context, communityID, userID string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.activeMember(communityID, userID)
+	return ok, nil
+}
+
+func (s *Storage) GetMemberRole(ctx context.Context, communityID, userID string) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	m, ok := s.activeMember(communityID, userID)
+	if !ok {
+		return "", storage.NewError(storage.ErrNotFound, "community member", "user %s is not a member of community %s", userID, communityID)
+	}
+	return m.Role, nil
+}
+
+func (s *Storage) UpdateMemberRole(ctx context.Context, communityID, userID, role string) (*storage.CommunityMember, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m, ok := s.activeMember(communityID, userID)
+	if !ok || m.Role == storage.RoleOwner {
+		return nil, storage.NewError(storage.ErrNotFound, "community member", "no member %s with a changeable role in community %s", userID, communityID)
+	}
+
+	m.Role = role
+	m.UpdatedAt = time.Now()
+
+	res := m.CommunityMember
+	return &res, nil
+}
+
+func (s *Storage) ListCommunityMembers(ctx context.Context, filter *storage.MemberListFilter) ([]*storage.CommunityMember, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	members := []*storage.CommunityMember{}
+	for key, m := range s.members {
+		if key.CommunityID != filter.CommunityID || m.DeletedAt != nil {
+			continue
+		}
+		res := m.CommunityMember
+		members = append(members, &res)
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].JoinedAt.Equal(members[j].JoinedAt) {
+			return members[i].UserID < members[j].UserID
+		}
+		return members[i].JoinedAt.Before(members[j].JoinedAt)
+	})
+
+	start, end := page(len(members), filter.Limit, filter.Offset)
+	return members[start:end], nil
+}
+
+func (s *Storage) ListUserCommunities(ctx context.Context, filter *storage.UserCommunityFilter) ([]*storage.UserCommunity, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	communities := []*storage.UserCommunity{}
+	for key, m := range s.members {
+		if key.UserID != filter.UserID || m.DeletedAt != nil {
+			continue
+		}
+		c, ok := s.activeCommunity(key.CommunityID)
+		if !ok {
+			continue
+		}
+		communities = append(communities, &storage.UserCommunity{
+			Community: c.Community,
+			Role:      m.Role,
+			JoinedAt:  m.JoinedAt,
+		})
+	}
+
+	sort.Slice(communities, func(i, j int) bool {
+		if communities[i].JoinedAt.Equal(communities[j].JoinedAt) {
+			return communities[i].Community.ID < communities[j].Community.ID
+		}
+		return communities[i].JoinedAt.After(communities[j].JoinedAt)
+	})
+
+	start, end := page(len(communities), filter.Limit, filter.Offset)
+	return communities[start:end], nil
+}
+
+// activeMember returns the membership unless it is missing or was left. Callers must hold s.mu.
+func (s *Storage) activeMember(communityID, userID string) (*memberRecord, bool) {
+	m, ok := s.members[memberKey{communityID, userID}]
+	if !ok || m.DeletedAt != nil {
+		return nil, false
+	}
+	return m, true
+}
+
+// TransferOwnership makes newOwnerID the owner and demotes the current owner to moderator.
+func (s *Storage) TransferOwnership(ctx context.Context, communityID, newOwnerID string) (*storage.CommunityMember, *storage.CommunityMember, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var previous *memberRecord
+	for _, m := range s.members {
+		if m.CommunityID == communityID && m.Role == storage.RoleOwner && m.DeletedAt == nil {
+			previous = m
+		}
+	}
+	if previous == nil {
+		return nil, nil, storage.NewError(storage.ErrNotFound, "community member", "community %s has no owner", communityID)
+	}
+	if previous.UserID == newOwnerID {
+		return nil, nil, &storage.Error{Kind: storage.ErrInvalidInput, Resource: "community member", Field: "new_owner_id", Err: fmt.Errorf("user %s already owns community %s", newOwnerID, communityID)}
+	}
+
+	owner, ok := s.activeMember(communityID, newOwnerID)
+	if !ok {
+		return nil, nil, storage.NewError(storage.ErrNotFound, "community member", "user %s is not a member of community %s", newOwnerID, communityID)
+	}
+
+	now := time.Now()
+	owner.Role = storage.RoleOwner
+	owner.UpdatedAt = now
+	previous.Role = storage.RoleModerator
+	previous.UpdatedAt = now
+
+	ownerRes, previousRes := owner.CommunityMember, previous.CommunityMember
+	return &ownerRes, &previousRes, nil
+}
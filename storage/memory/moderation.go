@@ -0,0 +1,126 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Projects/ComunityService/storage"
+)
+
+// logAction appends a copy of the action to the moderation log and returns another.
+// Callers must hold s.mu.
+func (s *Storage) logAction(action *storage.ModerationAction) *storage.ModerationAction {
+	stored := *action
+	stored.ID = newID()
+	stored.CreatedAt = time.Now()
+	if action.ExpiresAt != nil {
+		expiresAt := *action.ExpiresAt
+		stored.ExpiresAt = &expiresAt
+	}
+	s.moderation = append(s.moderation, &stored)
+
+	res := stored
+	return &res
+}
+
+// activeBan returns the user's ban unless it has expired. Callers must hold s.mu.
+func (s *Storage) activeBan(communityID, userID string) (*storage.CommunityBan, bool) {
+	ban, ok := s.bans[memberKey{communityID, userID}]
+	if !ok || (ban.ExpiresAt != nil && !time.Now().Before(*ban.ExpiresAt)) {
+		return nil, false
+	}
+	return ban, true
+}
+
+func (s *Storage) RemoveMember(ctx context.Context, action *storage.ModerationAction) (*storage.ModerationAction, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.leave(action.CommunityID, action.TargetUserID); err != nil {
+		return nil, err
+	}
+	return s.logAction(action), nil
+}
+
+func (s *Storage) BanMember(ctx context.Context, action *storage.ModerationAction) (*storage.ModerationAction, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.communities[action.CommunityID]; !ok {
+		return nil, storage.NewError(storage.ErrForeignKey, "community ban", "community %s does not exist", action.CommunityID)
+	}
+
+	ban := &storage.CommunityBan{
+		CommunityID: action.CommunityID,
+		UserID:      action.TargetUserID,
+		BannedBy:    action.ModeratorID,
+		Reason:      action.Reason,
+		CreatedAt:   time.Now(),
+	}
+	if action.ExpiresAt != nil {
+		expiresAt := *action.ExpiresAt
+		ban.ExpiresAt = &expiresAt
+	}
+	s.bans[memberKey{action.CommunityID, action.TargetUserID}] = ban
+
+	// The user may already be gone; the ban still keeps them from coming back.
+	if err := s.leave(action.CommunityID, action.TargetUserID); err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return nil, err
+	}
+
+	now := time.Now()
+	for _, r := range s.requests {
+		if r.CommunityID == action.CommunityID && r.UserID == action.TargetUserID && r.Status == storage.JoinRequestPending {
+			r.Status = storage.JoinRequestRejected
+			r.DecidedBy = action.ModeratorID
+			r.DecidedAt = &now
+			r.UpdatedAt = now
+		}
+	}
+
+	return s.logAction(action), nil
+}
+
+func (s *Storage) UnbanMember(ctx context.Context, action *storage.ModerationAction) (*storage.ModerationAction, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.activeBan(action.CommunityID, action.TargetUserID); !ok {
+		return nil, storage.NewError(storage.ErrNotFound, "community ban", "user %s is not banned from community %s", action.TargetUserID, action.CommunityID)
+	}
+	delete(s.bans, memberKey{action.CommunityID, action.TargetUserID})
+
+	return s.logAction(action), nil
+}
+
+func (s *Storage) GetActiveBan(ctx context.Context, communityID, userID string) (*storage.CommunityBan, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ban, ok := s.activeBan(communityID, userID)
+	if !ok {
+		return nil, storage.NewError(storage.ErrNotFound, "community ban", "user %s is not banned from community %s", userID, communityID)
+	}
+
+	res := *ban
+	return &res, nil
+}
+
+// ListModerationActions returns the community's moderation log newest first.
+func (s *Storage) ListModerationActions(ctx context.Context, filter *storage.ModerationLogFilter) ([]*storage.ModerationAction, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	// The log is appended in order, so walking it backwards yields newest first.
+	actions := []*storage.ModerationAction{}
+	for i := len(s.moderation) - 1; i >= 0; i-- {
+		if a := s.moderation[i]; a.CommunityID == filter.CommunityID {
+			res := *a
+			actions = append(actions, &res)
+		}
+	}
+
+	start, end := page(len(actions), filter.Limit, filter.Offset)
+	return actions[start:end], nil
+}
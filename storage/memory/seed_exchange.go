@@ -0,0 +1,240 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Projects/ComunityService/storage"
+)
+
+func (s *Storage) CreateSeedListing(ctx context.Context, listing *storage.SeedListing) (*storage.SeedListing, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.communities[listing.CommunityID]; !ok {
+		return nil, storage.NewError(storage.ErrForeignKey, "seed listing", "community %s does not exist", listing.CommunityID)
+	}
+	if listing.EventID != "" {
+		if _, ok := s.events[listing.EventID]; !ok {
+			return nil, storage.NewError(storage.ErrForeignKey, "seed listing", "event %s does not exist", listing.EventID)
+		}
+	}
+
+	now := time.Now()
+	listing.ID = newID()
+	listing.Status = storage.SeedListingOpen
+	listing.Available = listing.Quantity
+	listing.CreatedAt = now
+	listing.UpdatedAt = now
+	stored := *listing
+	s.listings[listing.ID] = &stored
+
+	res := *listing
+	return &res, nil
+}
+
+func (s *Storage) GetSeedListing(ctx context.Context, listingID string) (*storage.SeedListing, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	l, ok := s.listings[listingID]
+	if !ok {
+		return nil, storage.NewError(storage.ErrNotFound, "seed listing", "seed listing %s not found", listingID)
+	}
+	return s.seedListingCopy(l), nil
+}
+
+// ListSeedListings returns listings newest first.
+func (s *Storage) ListSeedListings(ctx context.Context, filter *storage.SeedListingFilter) ([]*storage.SeedListing, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	listings := []*storage.SeedListing{}
+	for _, l := range s.listings {
+		if filter.CommunityID != "" && l.CommunityID != filter.CommunityID {
+			continue
+		}
+		if filter.Kind != "" && l.Kind != filter.Kind {
+			continue
+		}
+		if filter.Species != "" && !strings.EqualFold(l.Species, filter.Species) {
+			continue
+		}
+		if filter.EventID != "" && l.EventID != filter.EventID {
+			continue
+		}
+		if filter.Status != "" && l.Status != filter.Status {
+			continue
+		}
+		listings = append(listings, s.seedListingCopy(l))
+	}
+	sort.Slice(listings, func(i, j int) bool {
+		if listings[i].CreatedAt.Equal(listings[j].CreatedAt) {
+			return listings[i].ID < listings[j].ID
+		}
+		return listings[i].CreatedAt.After(listings[j].CreatedAt)
+	})
+
+	start, end := page(len(listings), filter.Limit, filter.Offset)
+	return listings[start:end], nil
+}
+
+func (s *Storage) CloseSeedListing(ctx context.Context, listingID string) (*storage.SeedListing, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, ok := s.listings[listingID]
+	if !ok {
+		return nil, storage.NewError(storage.ErrNotFound, "seed listing", "seed listing %s not found", listingID)
+	}
+
+	now := time.Now()
+	l.Status = storage.SeedListingClosed
+	l.UpdatedAt = now
+	for _, m := range s.matches {
+		if m.ListingID == listingID && m.Status == storage.SeedMatchPending {
+			m.Status = storage.SeedMatchCancelled
+			m.UpdatedAt = now
+		}
+	}
+
+	return s.seedListingCopy(l), nil
+}
+
+func (s *Storage) CreateSeedMatch(ctx context.Context, match *storage.SeedMatch) (*storage.SeedMatch, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, ok := s.listings[match.ListingID]
+	if !ok {
+		return nil, storage.NewError(storage.ErrNotFound, "seed listing", "seed listing %s not found", match.ListingID)
+	}
+	if l.Status != storage.SeedListingOpen {
+		return nil, storage.NewError(storage.ErrConflict, "seed listing", "seed listing %s is %s", l.ID, l.Status)
+	}
+	if available := s.availableSeeds(l); match.Quantity > available {
+		return nil, &storage.Error{Kind: storage.ErrInvalidInput, Resource: "seed match", Field: "quantity",
+			Err: fmt.Errorf("only %d of seed listing %s are available", available, l.ID)}
+	}
+	if match.CounterListingID != "" {
+		if _, ok := s.listings[match.CounterListingID]; !ok {
+			return nil, storage.NewError(storage.ErrForeignKey, "seed match", "seed listing %s does not exist", match.CounterListingID)
+		}
+	}
+	for _, m := range s.matches {
+		if m.ListingID == match.ListingID && m.ClaimantID == match.ClaimantID &&
+			(m.Status == storage.SeedMatchPending || m.Status == storage.SeedMatchAccepted) {
+			return nil, storage.NewError(storage.ErrConflict, "seed match", "user %s already has a claim on seed listing %s", match.ClaimantID, match.ListingID)
+		}
+	}
+
+	now := time.Now()
+	match.ID = newID()
+	match.Status = storage.SeedMatchPending
+	match.CreatedAt = now
+	match.UpdatedAt = now
+	stored := *match
+	s.matches[match.ID] = &stored
+
+	res := *match
+	return &res, nil
+}
+
+func (s *Storage) GetSeedMatch(ctx context.Context, matchID string) (*storage.SeedMatch, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	m, ok := s.matches[matchID]
+	if !ok {
+		return nil, storage.NewError(storage.ErrNotFound, "seed match", "seed match %s not found", matchID)
+	}
+	res := *m
+	return &res, nil
+}
+
+func (s *Storage) UpdateSeedMatchStatus(ctx context.Context, matchID, from, to string) (*storage.SeedMatch, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m, ok := s.matches[matchID]
+	if !ok {
+		return nil, storage.NewError(storage.ErrNotFound, "seed match", "seed match %s not found", matchID)
+	}
+	if m.Status != from {
+		return nil, storage.NewError(storage.ErrConflict, "seed match", "seed match %s is no longer %s", matchID, from)
+	}
+
+	l := s.listings[m.ListingID]
+	if to == storage.SeedMatchAccepted {
+		if available := s.availableSeeds(l); m.Quantity > available {
+			return nil, storage.NewError(storage.ErrConflict, "seed listing", "only %d of seed listing %s are left", available, l.ID)
+		}
+	}
+
+	now := time.Now()
+	m.Status = to
+	m.UpdatedAt = now
+
+	if l.Status != storage.SeedListingClosed {
+		status := storage.SeedListingOpen
+		if s.availableSeeds(l) == 0 {
+			status = storage.SeedListingMatched
+		}
+		if status != l.Status {
+			l.Status = status
+			l.UpdatedAt = now
+		}
+	}
+
+	res := *m
+	return &res, nil
+}
+
+// ListSeedMatches returns matches newest first.
+func (s *Storage) ListSeedMatches(ctx context.Context, filter *storage.SeedMatchFilter) ([]*storage.SeedMatch, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	matches := []*storage.SeedMatch{}
+	for _, m := range s.matches {
+		if filter.ListingID != "" && m.ListingID != filter.ListingID {
+			continue
+		}
+		if filter.UserID != "" && m.ClaimantID != filter.UserID && s.listings[m.ListingID].UserID != filter.UserID {
+			continue
+		}
+		res := *m
+		matches = append(matches, &res)
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].CreatedAt.Equal(matches[j].CreatedAt) {
+			return matches[i].ID < matches[j].ID
+		}
+		return matches[i].CreatedAt.After(matches[j].CreatedAt)
+	})
+
+	start, end := page(len(matches), filter.Limit, filter.Offset)
+	return matches[start:end], nil
+}
+
+// availableSeeds returns the quantity of the listing not taken by accepted or completed
+// matches. Callers must hold s.mu.
+func (s *Storage) availableSeeds(l *storage.SeedListing) int32 {
+	available := l.Quantity
+	for _, m := range s.matches {
+		if m.ListingID == l.ID && (m.Status == storage.SeedMatchAccepted || m.Status == storage.SeedMatchCompleted) {
+			available -= m.Quantity
+		}
+	}
+	return available
+}
+
+// seedListingCopy returns a copy of the listing with Available filled in. Callers must hold s.mu.
+func (s *Storage) seedListingCopy(l *storage.SeedListing) *storage.SeedListing {
+	res := *l
+	res.Available = s.availableSeeds(l)
+	return &res
+}
@@ -0,0 +1,172 @@
+package memory
+
+import (
+	"crypto/rand"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Projects/ComunityService/storage"
+)
+
+// Storage keeps all data in process memory. It is safe for concurrent use and is meant
+// for unit tests and demo runs where no database is available.
+type Storage struct {
+	mu sync.RWMutex
+
+	communities map[string]*communityRecord
+	members     map[memberKey]*memberRecord
+	history     []*membershipPeriod
+	requests    map[string]*storage.JoinRequest
+	invites     map[string]*storage.CommunityInvite
+	events      map[string]*eventRecord
+	rsvps       map[rsvpKey]*storage.EventRsvp
+	exceptions  map[exceptionKey]*storage.EventException
+	changes     []*storage.EventChange
+	plantings   []*storage.EventPlanting
+	denials     []*storage.AuthorizationDenial
+	bans        map[memberKey]*storage.CommunityBan
+	moderation  []*storage.ModerationAction
+	listings    map[string]*storage.SeedListing
+	matches     map[string]*storage.SeedMatch
+	posts       map[string]*forumPostRecord
+	comments    map[string]*forumCommentRecord
+}
+
+type communityRecord struct {
+	storage.Community
+	DeletedAt *time.Time
+}
+
+type memberKey struct {
+	CommunityID string
+	UserID      string
+}
+
+type memberRecord struct {
+	storage.CommunityMember
+	DeletedAt *time.Time
+}
+
+type membershipPeriod struct {
+	CommunityID string
+	UserID      string
+	JoinedAt    time.Time
+	LeftAt      *time.Time
+}
+
+type eventRecord struct {
+	storage.Event
+	DeletedAt          *time.Time
+	CancellationReason string
+}
+
+type rsvpKey struct {
+	EventID string
+	UserID  string
+}
+
+type exceptionKey struct {
+	EventID       string
+	OriginalStart int64
+}
+
+type forumPostRecord struct {
+	storage.ForumPost
+	DeletedAt *time.Time
+}
+
+type forumCommentRecord struct {
+	storage.ForumComment
+	DeletedAt *time.Time
+}
+
+func NewStorage() storage.IStorage {
+	return &Storage{
+		communities: map[string]*communityRecord{},
+		members:     map[memberKey]*memberRecord{},
+		requests:    map[string]*storage.JoinRequest{},
+		invites:     map[string]*storage.CommunityInvite{},
+		bans:        map[memberKey]*storage.CommunityBan{},
+		events:      map[string]*eventRecord{},
+		rsvps:       map[rsvpKey]*storage.EventRsvp{},
+		exceptions:  map[exceptionKey]*storage.EventException{},
+		listings:    map[string]*storage.SeedListing{},
+		matches:     map[string]*storage.SeedMatch{},
+		posts:       map[string]*forumPostRecord{},
+		comments:    map[string]*forumCommentRecord{},
+	}
+}
+
+func (s *Storage) Community() storage.CommunityStorage {
+	return s
+}
+
+func (s *Storage) Member() storage.MemberStorage {
+	return s
+}
+
+func (s *Storage) Event() storage.EventStorage {
+	return s
+}
+
+func (s *Storage) Forum() storage.ForumStorage {
+	return s
+}
+
+func (s *Storage) SeedExchange() storage.SeedExchangeStorage {
+	return s
+}
+
+func (s *Storage) Audit() storage.AuditStorage {
+	return s
+}
+
+func (s *Storage) Moderation() storage.ModerationStorage {
+	return s
+}
+
+// newID returns a random version 4 UUID, matching what gen_random_uuid() produces.
+func newID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// page returns the bounds of the requested page within n items.
+func page(n int, limit, offset int32) (int, int) {
+	start := int(offset)
+	if start < 0 {
+		start = 0
+	}
+	if start > n {
+		start = n
+	}
+	end := n
+	if limit > 0 && start+int(limit) < n {
+		end = start + int(limit)
+	}
+	return start, end
+}
+
+// activeCommunity returns the community unless it is missing or soft-deleted. Callers must hold s.mu.
+func (s *Storage) activeCommunity(comId string) (*communityRecord, bool) {
+	c, ok := s.communities[comId]
+	if !ok || c.DeletedAt != nil {
+		return nil, false
+	}
+	return c, true
+}
+
+// visibleTo applies the VisibleTo rule of the community filters. Callers must hold s.mu.
+func (s *Storage) visibleTo(c *communityRecord, userID *string) bool {
+	if userID == nil || c.Visibility != storage.VisibilityPrivate {
+		return true
+	}
+	_, ok := s.activeMember(c.ID, *userID)
+	return ok
+}
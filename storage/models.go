@@ -0,0 +1,438 @@
+package storage
+
+import "time"
+
+type Community struct {
+	ID          string    `json:"id,omitempty"`
+	Name        string    `json:"name,omitempty"`
+	Description string    `json:"description,omitempty"`
+	Location    string    `json:"location,omitempty"`
+	Latitude    *float64  `json:"latitude,omitempty"`
+	Longitude   *float64  `json:"longitude,omitempty"`
+	Visibility  string    `json:"visibility,omitempty"`
+	CreatedAt   time.Time `json:"created_at,omitempty"`
+	UpdatedAt   time.Time `json:"updated_at,omitempty"`
+}
+
+const (
+	VisibilityPublic     = "public"
+	VisibilityPrivate    = "private"
+	VisibilityInviteOnly = "invite_only"
+)
+
+// DeletedCommunity is a soft-deleted community together with who owned it when it was
+// deleted.
+type DeletedCommunity struct {
+	Community
+	OwnerID   string    `json:"owner_id,omitempty"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+type Event struct {
+	ID          string    `json:"id,omitempty"`
+	CommunityID string    `json:"community_id,omitempty"`
+	Name        string    `json:"name,omitempty"`
+	Description string    `json:"description,omitempty"`
+	EventType   string    `json:"event_type,omitempty"`
+	StartTime   time.Time `json:"start_time"`
+	EndTime     time.Time `json:"end_time"`
+	Location    string    `json:"location,omitempty"`
+	Latitude    *float64  `json:"latitude,omitempty"`
+	Longitude   *float64  `json:"longitude,omitempty"`
+	Capacity    *int32    `json:"capacity,omitempty"`
+	// RecurrenceRule is an iCalendar RRULE anchored at StartTime; empty for one-off events.
+	RecurrenceRule string `json:"recurrence_rule,omitempty"`
+	// GardenID is the Garden Management Service garden a community_planting event takes place at.
+	GardenID  string     `json:"garden_id,omitempty"`
+	CreatedAt time.Time  `json:"created_at,omitempty"`
+	UpdatedAt time.Time  `json:"updated_at,omitempty"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+type CommunityUpdateFilter struct {
+	ID          *string  `json:"id,omitempty"`
+	Name        *string  `json:"name,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Location    *string  `json:"location,omitempty"`
+	Latitude    *float64 `json:"latitude,omitempty"`
+	Longitude   *float64 `json:"longitude,omitempty"`
+	Visibility  *string  `json:"visibility,omitempty"`
+}
+
+// CommunityGetFilter selects communities to list. When VisibleTo is set, private
+// communities are only included if that user is a member of them; an empty VisibleTo
+// leaves them all out.
+type CommunityGetFilter struct {
+	Name      *string          `json:"name,omitempty"`
+	Location  *string          `json:"location,omitempty"`
+	VisibleTo *string          `json:"visible_to,omitempty"`
+	Limit     *int32           `json:"limit,omitempty"`
+	Offset    *int32           `json:"offset,omitempty"`
+	After     *CommunityCursor `json:"after,omitempty"`
+}
+
+// CommunityCursor is the keyset position of a community in (created_at, id) order.
+type CommunityCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        string    `json:"id"`
+}
+
+const (
+	RoleOwner     = "owner"
+	RoleModerator = "moderator"
+	RoleMember    = "member"
+)
+
+type CommunityMember struct {
+	CommunityID string    `json:"community_id,omitempty"`
+	UserID      string    `json:"user_id,omitempty"`
+	Role        string    `json:"role,omitempty"`
+	JoinedAt    time.Time `json:"joined_at,omitempty"`
+	CreatedAt   time.Time `json:"created_at,omitempty"`
+	UpdatedAt   time.Time `json:"updated_at,omitempty"`
+}
+
+type MemberListFilter struct {
+	CommunityID string `json:"community_id,omitempty"`
+	Limit       int32  `json:"limit,omitempty"`
+	Offset      int32  `json:"offset,omitempty"`
+}
+
+type UserCommunity struct {
+	Community Community `json:"community"`
+	Role      string    `json:"role,omitempty"`
+	JoinedAt  time.Time `json:"joined_at,omitempty"`
+}
+
+type UserCommunityFilter struct {
+	UserID string `json:"user_id,omitempty"`
+	Limit  int32  `json:"limit,omitempty"`
+	Offset int32  `json:"offset,omitempty"`
+}
+
+type JoinCommunity struct {
+	CommunityID string `json:"community_id,omitempty"`
+	UserID      string `json:"user_id,omitempty"`
+	JoinedAt    string `json:"joined_at,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+}
+
+const (
+	JoinRequestPending  = "pending"
+	JoinRequestApproved = "approved"
+	JoinRequestRejected = "rejected"
+)
+
+// JoinRequest asks to join a private community. DecidedBy and DecidedAt are set once a
+// moderator approves or rejects it.
+type JoinRequest struct {
+	ID          string     `json:"id,omitempty"`
+	CommunityID string     `json:"community_id,omitempty"`
+	UserID      string     `json:"user_id,omitempty"`
+	Status      string     `json:"status,omitempty"`
+	DecidedBy   string     `json:"decided_by,omitempty"`
+	DecidedAt   *time.Time `json:"decided_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at,omitempty"`
+	UpdatedAt   time.Time  `json:"updated_at,omitempty"`
+}
+
+type JoinRequestFilter struct {
+	CommunityID string `json:"community_id,omitempty"`
+	Status      string `json:"status,omitempty"`
+	Limit       int32  `json:"limit,omitempty"`
+	Offset      int32  `json:"offset,omitempty"`
+}
+
+// CommunityInvite admits up to MaxUses users to a community until ExpiresAt.
+type CommunityInvite struct {
+	ID          string    `json:"id,omitempty"`
+	CommunityID string    `json:"community_id,omitempty"`
+	CreatedBy   string    `json:"created_by,omitempty"`
+	MaxUses     int32     `json:"max_uses,omitempty"`
+	Uses        int32     `json:"uses"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	CreatedAt   time.Time `json:"created_at,omitempty"`
+}
+
+const (
+	ModerationRemove = "remove"
+	ModerationBan    = "ban"
+	ModerationUnban  = "unban"
+)
+
+// ModerationAction is an entry of the moderation log. ExpiresAt is only set on bans that
+// expire.
+type ModerationAction struct {
+	ID           string     `json:"id,omitempty"`
+	CommunityID  string     `json:"community_id,omitempty"`
+	ModeratorID  string     `json:"moderator_id,omitempty"`
+	TargetUserID string     `json:"target_user_id,omitempty"`
+	Action       string     `json:"action,omitempty"`
+	Reason       string     `json:"reason,omitempty"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at,omitempty"`
+}
+
+type ModerationLogFilter struct {
+	CommunityID string `json:"community_id,omitempty"`
+	Limit       int32  `json:"limit,omitempty"`
+	Offset      int32  `json:"offset,omitempty"`
+}
+
+// CommunityBan keeps a user out of a community until ExpiresAt, or for good when it is nil.
+type CommunityBan struct {
+	CommunityID string     `json:"community_id,omitempty"`
+	UserID      string     `json:"user_id,omitempty"`
+	BannedBy    string     `json:"banned_by,omitempty"`
+	Reason      string     `json:"reason,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at,omitempty"`
+}
+
+type LeaveCommunity struct {
+	CommunityId string `json:"community_id,omitempty"`
+	UserID      string `json:"user_id,omitempty"`
+}
+
+type ForumPost struct {
+	ID          string     `json:"id,omitempty"`
+	CommunityID string     `json:"community_id,omitempty"`
+	UserID      string     `json:"user_id,omitempty"`
+	Title       string     `json:"title,omitempty"`
+	Content     string     `json:"content,omitempty"`
+	ArchivedAt  *time.Time `json:"archived_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at,omitempty"`
+	UpdatedAt   time.Time  `json:"updated_at,omitempty"`
+}
+
+type ForumComment struct {
+	ID        string    `json:"id,omitempty"`
+	ForumID   string    `json:"forum_id,omitempty"`
+	UserID    string    `json:"user_id,omitempty"`
+	Content   string    `json:"content,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// CommunitySearchFilter and NearbyFilter restrict private communities by VisibleTo the
+// same way CommunityGetFilter does.
+type CommunitySearchFilter struct {
+	Query     string  `json:"query,omitempty"`
+	VisibleTo *string `json:"visible_to,omitempty"`
+	Limit     int32   `json:"limit,omitempty"`
+	Offset    int32   `json:"offset,omitempty"`
+}
+
+type CommunitySearchResult struct {
+	Community     Community `json:"community"`
+	Rank          float64   `json:"rank"`
+	NameHighlight string    `json:"name_highlight,omitempty"`
+	Snippet       string    `json:"snippet,omitempty"`
+}
+
+type NearbyFilter struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	RadiusKm  float64 `json:"radius_km"`
+	VisibleTo *string `json:"visible_to,omitempty"`
+	Limit     int32   `json:"limit,omitempty"`
+}
+
+type NearbyCommunity struct {
+	Community  Community `json:"community"`
+	DistanceKm float64   `json:"distance_km"`
+}
+
+const (
+	RsvpGoing      = "going"
+	RsvpMaybe      = "maybe"
+	RsvpDeclined   = "declined"
+	RsvpWaitlisted = "waitlisted"
+)
+
+type EventRsvp struct {
+	EventID   string    `json:"event_id,omitempty"`
+	UserID    string    `json:"user_id,omitempty"`
+	Status    string    `json:"status,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+type AttendeeListFilter struct {
+	EventID string `json:"event_id,omitempty"`
+	Status  string `json:"status,omitempty"`
+	Limit   int32  `json:"limit,omitempty"`
+	Offset  int32  `json:"offset,omitempty"`
+}
+
+// EventException overrides a single occurrence of a recurring event, identified by the
+// start time the rule generated for it. A moved occurrence carries its new times.
+type EventException struct {
+	EventID       string     `json:"event_id,omitempty"`
+	OriginalStart time.Time  `json:"original_start"`
+	Cancelled     bool       `json:"cancelled"`
+	StartTime     *time.Time `json:"start_time,omitempty"`
+	EndTime       *time.Time `json:"end_time,omitempty"`
+	CreatedAt     time.Time  `json:"created_at,omitempty"`
+	UpdatedAt     time.Time  `json:"updated_at,omitempty"`
+}
+
+// EventListFilter selects events in (start_time, id) order. Recurring events are matched
+// on their series start only; callers expand them to check individual occurrences.
+type EventListFilter struct {
+	CommunityID string `json:"community_id,omitempty"`
+	// MemberID restricts the listing to communities the user is an active member of.
+	MemberID string   `json:"member_id,omitempty"`
+	Types    []string `json:"types,omitempty"`
+	// From and To select events overlapping [From, To].
+	From        *time.Time   `json:"from,omitempty"`
+	To          *time.Time   `json:"to,omitempty"`
+	StartsAfter *time.Time   `json:"starts_after,omitempty"`
+	EndsBefore  *time.Time   `json:"ends_before,omitempty"`
+	After       *EventCursor `json:"after,omitempty"`
+	Limit       int32        `json:"limit,omitempty"`
+}
+
+// EventCursor is the keyset position of an event in (start_time, id) order.
+type EventCursor struct {
+	StartTime time.Time `json:"start_time"`
+	ID        string    `json:"id"`
+}
+
+// EventUpdateFilter holds the event fields to change; nil fields are left as they are.
+type EventUpdateFilter struct {
+	ID          *string    `json:"id,omitempty"`
+	Name        *string    `json:"name,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	EventType   *string    `json:"event_type,omitempty"`
+	StartTime   *time.Time `json:"start_time,omitempty"`
+	EndTime     *time.Time `json:"end_time,omitempty"`
+	Location    *string    `json:"location,omitempty"`
+	Latitude    *float64   `json:"latitude,omitempty"`
+	Longitude   *float64   `json:"longitude,omitempty"`
+	// ClearCoordinates removes the event's coordinates.
+	ClearCoordinates bool `json:"clear_coordinates,omitempty"`
+	// Capacity 0 makes the event unlimited.
+	Capacity *int32 `json:"capacity,omitempty"`
+	// RecurrenceRule "" makes the event a one-off.
+	RecurrenceRule *string `json:"recurrence_rule,omitempty"`
+	// GardenID "" unlinks the event from its garden.
+	GardenID *string `json:"garden_id,omitempty"`
+}
+
+// EventPlanting records plants put into the event's garden; PlantID is the plant created
+// in the Garden Management Service.
+type EventPlanting struct {
+	ID         string    `json:"id,omitempty"`
+	EventID    string    `json:"event_id,omitempty"`
+	GardenID   string    `json:"garden_id,omitempty"`
+	PlantID    string    `json:"plant_id,omitempty"`
+	Species    string    `json:"species,omitempty"`
+	Quantity   int32     `json:"quantity,omitempty"`
+	RecordedBy string    `json:"recorded_by,omitempty"`
+	CreatedAt  time.Time `json:"created_at,omitempty"`
+}
+
+const (
+	EventChangeUpdated   = "updated"
+	EventChangeCancelled = "cancelled"
+	EventChangeRestored  = "restored"
+)
+
+// CommunityDeletedReason is the cancellation reason of events cancelled because their
+// community was deleted.
+const CommunityDeletedReason = "community deleted"
+
+type EventFieldChange struct {
+	Field    string `json:"field"`
+	OldValue string `json:"old_value"`
+	NewValue string `json:"new_value"`
+}
+
+type EventChange struct {
+	ID         string             `json:"id,omitempty"`
+	EventID    string             `json:"event_id,omitempty"`
+	ChangedBy  string             `json:"changed_by,omitempty"`
+	ChangeType string             `json:"change_type,omitempty"`
+	Changes    []EventFieldChange `json:"changes"`
+	Reason     string             `json:"reason,omitempty"`
+	CreatedAt  time.Time          `json:"created_at,omitempty"`
+}
+
+const (
+	SeedOffer = "offer"
+	SeedWant  = "want"
+
+	SeedListingOpen    = "open"
+	SeedListingMatched = "matched"
+	SeedListingClosed  = "closed"
+
+	SeedMatchPending   = "pending"
+	SeedMatchAccepted  = "accepted"
+	SeedMatchDeclined  = "declined"
+	SeedMatchCancelled = "cancelled"
+	SeedMatchCompleted = "completed"
+)
+
+// SeedListing is an offer or want of seeds. Available is Quantity minus the quantity of
+// its accepted and completed matches.
+type SeedListing struct {
+	ID          string    `json:"id,omitempty"`
+	CommunityID string    `json:"community_id,omitempty"`
+	UserID      string    `json:"user_id,omitempty"`
+	Kind        string    `json:"kind,omitempty"`
+	Species     string    `json:"species,omitempty"`
+	Quantity    int32     `json:"quantity,omitempty"`
+	Available   int32     `json:"available"`
+	GardenID    string    `json:"garden_id,omitempty"`
+	EventID     string    `json:"event_id,omitempty"`
+	Notes       string    `json:"notes,omitempty"`
+	Status      string    `json:"status,omitempty"`
+	CreatedAt   time.Time `json:"created_at,omitempty"`
+	UpdatedAt   time.Time `json:"updated_at,omitempty"`
+}
+
+type SeedListingFilter struct {
+	CommunityID string `json:"community_id,omitempty"`
+	Kind        string `json:"kind,omitempty"`
+	Species     string `json:"species,omitempty"`
+	EventID     string `json:"event_id,omitempty"`
+	Status      string `json:"status,omitempty"`
+	Limit       int32  `json:"limit,omitempty"`
+	Offset      int32  `json:"offset,omitempty"`
+}
+
+type SeedMatch struct {
+	ID               string    `json:"id,omitempty"`
+	ListingID        string    `json:"listing_id,omitempty"`
+	ClaimantID       string    `json:"claimant_id,omitempty"`
+	CounterListingID string    `json:"counter_listing_id,omitempty"`
+	Quantity         int32     `json:"quantity,omitempty"`
+	Status           string    `json:"status,omitempty"`
+	CreatedAt        time.Time `json:"created_at,omitempty"`
+	UpdatedAt        time.Time `json:"updated_at,omitempty"`
+}
+
+// SeedMatchFilter selects the matches on a listing, or the matches a user is part of as
+// claimant or listing owner.
+type SeedMatchFilter struct {
+	ListingID string `json:"listing_id,omitempty"`
+	UserID    string `json:"user_id,omitempty"`
+	Limit     int32  `json:"limit,omitempty"`
+	Offset    int32  `json:"offset,omitempty"`
+}
+
+// AuthorizationDenial records a caller the authorization policy turned away. Role is the
+// user's role in the community, empty when they are not a member.
+type AuthorizationDenial struct {
+	ID          string    `json:"id,omitempty"`
+	UserID      string    `json:"user_id,omitempty"`
+	IsAdmin     bool      `json:"is_admin,omitempty"`
+	Permission  string    `json:"permission,omitempty"`
+	CommunityID string    `json:"community_id,omitempty"`
+	Role        string    `json:"role,omitempty"`
+	Method      string    `json:"method,omitempty"`
+	Reason      string    `json:"reason,omitempty"`
+	CreatedAt   time.Time `json:"created_at,omitempty"`
+}
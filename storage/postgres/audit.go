@@ -0,0 +1,40 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/Projects/ComunityService/storage"
+	"github.com/jmoiron/sqlx"
+)
+
+type AuditRepository struct {
+	db *sqlx.DB
+}
+
+func NewAuditRepository(db *sqlx.DB) *AuditRepository {
+	return &AuditRepository{db: db}
+}
+
+func (a *AuditRepository) RecordDenial(ctx context.Context, denial *storage.AuthorizationDenial) error {
+	query :=
+		`
+		INSERT INTO authorization_denials (user_id, is_admin, permission, community_id, role, method, reason)
+		VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($5, ''), NULLIF($6, ''), $7)
+		RETURNING id, created_at
+	`
+
+	err := a.db.QueryRowContext(ctx, query,
+		denial.UserID,
+		denial.IsAdmin,
+		denial.Permission,
+		denial.CommunityID,
+		denial.Role,
+		denial.Method,
+		denial.Reason,
+	).Scan(&denial.ID, &denial.CreatedAt)
+	if err != nil {
+		return wrapError("authorization denial", err)
+	}
+
+	return nil
+}
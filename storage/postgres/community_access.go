@@ -0,0 +1,171 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Projects/ComunityService/storage"
+)
+
+const joinRequestColumns = "id, community_id, user_id, status, coalesce(decided_by::text, ''), decided_at, created_at, updated_at"
+
+const inviteColumns = "id, community_id, created_by, max_uses, uses, expires_at, created_at"
+
+func scanJoinRequest(row interface{ Scan(...interface{}) error }, r *storage.JoinRequest) error {
+	return row.Scan(&r.ID, &r.CommunityID, &r.UserID, &r.Status, &r.DecidedBy, &r.DecidedAt, &r.CreatedAt, &r.UpdatedAt)
+}
+
+func scanInvite(row interface{ Scan(...interface{}) error }, i *storage.CommunityInvite) error {
+	return row.Scan(&i.ID, &i.CommunityID, &i.CreatedBy, &i.MaxUses, &i.Uses, &i.ExpiresAt, &i.CreatedAt)
+}
+
+func (cs *CommunityRepository) CreateJoinRequest(ctx context.Context, request *storage.JoinRequest) (*storage.JoinRequest, error) {
+	isMember, err := cs.IsCommunityMember(ctx, request.CommunityID, request.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if isMember {
+		return nil, storage.NewError(storage.ErrConflict, "join request", "user %s is already a member of community %s", request.UserID, request.CommunityID)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO community_join_requests (community_id, user_id)
+		VALUES ($1, $2)
+		RETURNING %s`, joinRequestColumns)
+
+	res := &storage.JoinRequest{}
+	if err := scanJoinRequest(cs.db.QueryRowContext(ctx, query, request.CommunityID, request.UserID), res); err != nil {
+		return nil, wrapError("join request", err)
+	}
+	return res, nil
+}
+
+func (cs *CommunityRepository) GetJoinRequest(ctx context.Context, requestID string) (*storage.JoinRequest, error) {
+	query := fmt.Sprintf(`SELECT %s FROM community_join_requests WHERE id = $1`, joinRequestColumns)
+
+	res := &storage.JoinRequest{}
+	if err := scanJoinRequest(cs.db.QueryRowContext(ctx, query, requestID), res); err != nil {
+		return nil, wrapError("join request", err)
+	}
+	return res, nil
+}
+
+// ListJoinRequests returns the community's requests oldest first.
+func (cs *CommunityRepository) ListJoinRequests(ctx context.Context, filter *storage.JoinRequestFilter) ([]*storage.JoinRequest, error) {
+	query := fmt.Sprintf(`
+		SELECT %s FROM community_join_requests
+		WHERE community_id = $1 AND status = $2
+		ORDER BY created_at, id
+		LIMIT $3 OFFSET $4`, joinRequestColumns)
+
+	rows, err := cs.db.QueryContext(ctx, query, filter.CommunityID, filter.Status, filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, wrapError("join request", err)
+	}
+	defer rows.Close()
+
+	requests := []*storage.JoinRequest{}
+	for rows.Next() {
+		r := &storage.JoinRequest{}
+		if err := scanJoinRequest(rows, r); err != nil {
+			return nil, fmt.Errorf("failed to scan join request: %w", err)
+		}
+		requests = append(requests, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapError("join request", err)
+	}
+
+	return requests, nil
+}
+
+func (cs *CommunityRepository) DecideJoinRequest(ctx context.Context, requestID, status, decidedBy string) (*storage.JoinRequest, error) {
+	tx, err := cs.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := fmt.Sprintf(`
+		UPDATE community_join_requests
+		SET status = $3, decided_by = $4, decided_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND status = $2
+		RETURNING %s`, joinRequestColumns)
+
+	res := &storage.JoinRequest{}
+	err = scanJoinRequest(tx.QueryRowContext(ctx, query, requestID, storage.JoinRequestPending, status, decidedBy), res)
+	if errors.Is(err, sql.ErrNoRows) {
+		if _, err := cs.GetJoinRequest(ctx, requestID); err != nil {
+			return nil, err
+		}
+		return nil, storage.NewError(storage.ErrConflict, "join request", "join request %s is no longer pending", requestID)
+	}
+	if err != nil {
+		return nil, wrapError("join request", err)
+	}
+
+	if status == storage.JoinRequestApproved {
+		if err := joinCommunityTx(ctx, tx, &storage.JoinCommunity{CommunityID: res.CommunityID, UserID: res.UserID}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit join request: %w", err)
+	}
+
+	return res, nil
+}
+
+func (cs *CommunityRepository) CreateInvite(ctx context.Context, invite *storage.CommunityInvite) (*storage.CommunityInvite, error) {
+	query := fmt.Sprintf(`
+		INSERT INTO community_invites (community_id, created_by, max_uses, expires_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING %s`, inviteColumns)
+
+	res := &storage.CommunityInvite{}
+	err := scanInvite(cs.db.QueryRowContext(ctx, query, invite.CommunityID, invite.CreatedBy, invite.MaxUses, invite.ExpiresAt), res)
+	if err != nil {
+		return nil, wrapError("community invite", err)
+	}
+	return res, nil
+}
+
+func (cs *CommunityRepository) RedeemInvite(ctx context.Context, inviteID, communityID, userID string) (*storage.JoinCommunity, error) {
+	tx, err := cs.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := fmt.Sprintf(`SELECT %s FROM community_invites WHERE id = $1 AND community_id = $2 FOR UPDATE`, inviteColumns)
+
+	invite := &storage.CommunityInvite{}
+	if err := scanInvite(tx.QueryRowContext(ctx, query, inviteID, communityID), invite); err != nil {
+		return nil, wrapError("community invite", err)
+	}
+	if !time.Now().Before(invite.ExpiresAt) {
+		return nil, &storage.Error{Kind: storage.ErrInvalidInput, Resource: "community invite", Field: "invite_code", Err: fmt.Errorf("invite %s has expired", inviteID)}
+	}
+	if invite.Uses >= invite.MaxUses {
+		return nil, &storage.Error{Kind: storage.ErrInvalidInput, Resource: "community invite", Field: "invite_code", Err: fmt.Errorf("invite %s has been used up", inviteID)}
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE community_invites SET uses = uses + 1 WHERE id = $1`, inviteID); err != nil {
+		return nil, wrapError("community invite", err)
+	}
+
+	jCom := &storage.JoinCommunity{CommunityID: communityID, UserID: userID}
+	if err := joinCommunityTx(ctx, tx, jCom); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit invite redemption: %w", err)
+	}
+
+	return jCom, nil
+}
@@ -1,3 +1,229 @@
 package postgres
 
-// func (c *CommunityRepository) CreateCommunityEvent(ctx context.Context,
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Projects/ComunityService/storage"
+	"github.com/lib/pq"
+)
+
+func (c *CommunityRepository) CreateCommunityEvent(ctx context.Context, event *storage.Event) (*storage.Event, error) {
+	query :=
+		`
+		INSERT INTO events (community_id, name, description, type, start_time, end_time, location, latitude, longitude, capacity, recurrence_rule, garden_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NULLIF($11, ''), NULLIF($12, '')::uuid)
+		RETURNING id, community_id, name, description, type, start_time, end_time, location, latitude, longitude, capacity, coalesce(recurrence_rule, ''), coalesce(garden_id::text, ''), created_at, updated_at
+	`
+
+	err := c.db.QueryRowContext(ctx, query,
+		event.CommunityID,
+		event.Name,
+		event.Description,
+		event.EventType,
+		event.StartTime,
+		event.EndTime,
+		event.Location,
+		event.Latitude,
+		event.Longitude,
+		event.Capacity,
+		event.RecurrenceRule,
+		event.GardenID,
+	).Scan(
+		&event.ID,
+		&event.CommunityID,
+		&event.Name,
+		&event.Description,
+		&event.EventType,
+		&event.StartTime,
+		&event.EndTime,
+		&event.Location,
+		&event.Latitude,
+		&event.Longitude,
+		&event.Capacity,
+		&event.RecurrenceRule,
+		&event.GardenID,
+		&event.CreatedAt,
+		&event.UpdatedAt,
+	)
+	if err != nil {
+		return nil, wrapError("event", err)
+	}
+
+	return event, nil
+}
+
+func (c *CommunityRepository) GetCommunityEvent(ctx context.Context, eventID string) (*storage.Event, error) {
+	query :=
+		`
+		SELECT id, community_id, name, description, type, start_time, end_time, location, latitude, longitude, capacity, coalesce(recurrence_rule, ''), coalesce(garden_id::text, ''), created_at, updated_at
+		FROM events
+		WHERE deleted_at IS NULL AND id = $1
+	`
+
+	event := &storage.Event{}
+	err := c.db.QueryRowContext(ctx, query, eventID).Scan(
+		&event.ID,
+		&event.CommunityID,
+		&event.Name,
+		&event.Description,
+		&event.EventType,
+		&event.StartTime,
+		&event.EndTime,
+		&event.Location,
+		&event.Latitude,
+		&event.Longitude,
+		&event.Capacity,
+		&event.RecurrenceRule,
+		&event.GardenID,
+		&event.CreatedAt,
+		&event.UpdatedAt,
+	)
+	if err != nil {
+		return nil, wrapError("event", err)
+	}
+
+	return event, nil
+}
+
+func (c *CommunityRepository) ListCalendarEvents(ctx context.Context, communityID string, since time.Time) ([]*storage.Event, error) {
+	query :=
+		`
+		SELECT id, community_id, name, description, type, start_time, end_time, location, latitude, longitude, capacity, coalesce(recurrence_rule, ''), coalesce(garden_id::text, ''), created_at, updated_at, deleted_at
+		FROM events
+		WHERE community_id = $1 AND (recurrence_rule IS NOT NULL OR end_time >= $2)
+		ORDER BY start_time, id
+	`
+
+	rows, err := c.db.QueryContext(ctx, query, communityID, since)
+	if err != nil {
+		return nil, wrapError("event", err)
+	}
+	defer rows.Close()
+
+	events := []*storage.Event{}
+	for rows.Next() {
+		event := &storage.Event{}
+		if err := rows.Scan(
+			&event.ID,
+			&event.CommunityID,
+			&event.Name,
+			&event.Description,
+			&event.EventType,
+			&event.StartTime,
+			&event.EndTime,
+			&event.Location,
+			&event.Latitude,
+			&event.Longitude,
+			&event.Capacity,
+			&event.RecurrenceRule,
+			&event.GardenID,
+			&event.CreatedAt,
+			&event.UpdatedAt,
+			&event.DeletedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan event: %w", err)
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapError("event", err)
+	}
+
+	return events, nil
+}
+
+// ListEvents returns active events in (start_time, id) order, starting after filter.After
+// when it is set.
+func (c *CommunityRepository) ListEvents(ctx context.Context, filter *storage.EventListFilter) ([]*storage.Event, error) {
+	params := []string{"e.deleted_at IS NULL"}
+	args := []interface{}{}
+
+	if filter.CommunityID != "" {
+		args = append(args, filter.CommunityID)
+		params = append(params, fmt.Sprintf("e.community_id = $%d", len(args)))
+	}
+	if filter.MemberID != "" {
+		args = append(args, filter.MemberID)
+		params = append(params, fmt.Sprintf(`e.community_id IN (
+			SELECT m.community_id FROM community_members m
+			JOIN communities c ON c.id = m.community_id
+			WHERE m.user_id = $%d AND m.deleted_at IS NULL AND c.deleted_at IS NULL)`, len(args)))
+	}
+	if len(filter.Types) > 0 {
+		args = append(args, pq.Array(filter.Types))
+		params = append(params, fmt.Sprintf("e.type::text = ANY($%d)", len(args)))
+	}
+	if filter.To != nil {
+		args = append(args, *filter.To)
+		params = append(params, fmt.Sprintf("e.start_time <= $%d", len(args)))
+	}
+
+	// Conditions on a single occurrence only apply to one-off events; a recurring series
+	// only has to have started before the window closes.
+	oneOff := []string{}
+	if filter.From != nil {
+		args = append(args, *filter.From)
+		oneOff = append(oneOff, fmt.Sprintf("e.end_time >= $%d", len(args)))
+	}
+	if filter.StartsAfter != nil {
+		args = append(args, *filter.StartsAfter)
+		oneOff = append(oneOff, fmt.Sprintf("e.start_time >= $%d", len(args)))
+	}
+	if filter.EndsBefore != nil {
+		args = append(args, *filter.EndsBefore)
+		params = append(params, fmt.Sprintf("e.start_time < $%d", len(args)))
+		oneOff = append(oneOff, fmt.Sprintf("e.end_time < $%d", len(args)))
+	}
+	if len(oneOff) > 0 {
+		params = append(params, fmt.Sprintf("(e.recurrence_rule IS NOT NULL OR (%s))", strings.Join(oneOff, " AND ")))
+	}
+
+	if filter.After != nil {
+		args = append(args, filter.After.StartTime, filter.After.ID)
+		params = append(params, fmt.Sprintf("(e.start_time, e.id) > ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	args = append(args, filter.Limit)
+	query := fmt.Sprintf(`SELECT e.id, e.community_id, e.name, e.description, e.type, e.start_time, e.end_time, e.location,
+		e.latitude, e.longitude, e.capacity, coalesce(e.recurrence_rule, ''), coalesce(e.garden_id::text, ''), e.created_at, e.updated_at
+		FROM events e WHERE %s ORDER BY e.start_time, e.id LIMIT $%d`, strings.Join(params, " AND "), len(args))
+
+	rows, err := c.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, wrapError("event", err)
+	}
+	defer rows.Close()
+
+	events := []*storage.Event{}
+	for rows.Next() {
+		event := &storage.Event{}
+		if err := rows.Scan(
+			&event.ID,
+			&event.CommunityID,
+			&event.Name,
+			&event.Description,
+			&event.EventType,
+			&event.StartTime,
+			&event.EndTime,
+			&event.Location,
+			&event.Latitude,
+			&event.Longitude,
+			&event.Capacity,
+			&event.RecurrenceRule,
+			&event.GardenID,
+			&event.CreatedAt,
+			&event.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan event: %w", err)
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapError("event", err)
+	}
+
+	return events, nil
+}
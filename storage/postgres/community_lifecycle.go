@@ -0,0 +1,209 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Projects/ComunityService/storage"
+	"github.com/jmoiron/sqlx"
+)
+
+// DeleteCommunity stamps everything the cascade closes with the community's deleted_at,
+// so that RestoreCommunity can tell it apart from what was closed before.
+func (c *CommunityRepository) DeleteCommunity(ctx context.Context, comId, deletedBy string) error {
+	tx, err := c.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var deletedAt time.Time
+	err = tx.QueryRowContext(ctx, `UPDATE communities SET deleted_at = NOW(), updated_at = NOW() WHERE id = $1 AND deleted_at IS NULL RETURNING deleted_at`, comId).Scan(&deletedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return storage.NewError(storage.ErrNotFound, "community", "community %s not found", comId)
+	}
+	if err != nil {
+		return wrapError("community", err)
+	}
+
+	membersQuery :=
+		`
+		UPDATE community_members SET deleted_at = $2, updated_at = NOW()
+		WHERE community_id = $1 AND deleted_at IS NULL
+	`
+
+	if _, err := tx.ExecContext(ctx, membersQuery, comId, deletedAt); err != nil {
+		return wrapError("community member", err)
+	}
+
+	historyQuery :=
+		`
+		UPDATE community_membership_history SET left_at = $2
+		WHERE community_id = $1 AND left_at IS NULL
+	`
+
+	if _, err := tx.ExecContext(ctx, historyQuery, comId, deletedAt); err != nil {
+		return wrapError("community membership history", err)
+	}
+
+	eventsQuery :=
+		`
+		UPDATE events SET deleted_at = $2, updated_at = NOW(), cancellation_reason = $3
+		WHERE community_id = $1 AND deleted_at IS NULL AND (start_time > $2 OR recurrence_rule IS NOT NULL)
+		RETURNING id
+	`
+
+	if err := logEventChanges(ctx, tx, eventsQuery, storage.EventChangeCancelled, deletedBy, comId, deletedAt, storage.CommunityDeletedReason); err != nil {
+		return err
+	}
+
+	postsQuery :=
+		`
+		UPDATE forum_posts SET archived_at = $2
+		WHERE community_id = $1 AND deleted_at IS NULL AND archived_at IS NULL
+	`
+
+	if _, err := tx.ExecContext(ctx, postsQuery, comId, deletedAt); err != nil {
+		return wrapError("forum post", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit community deletion: %w", err)
+	}
+
+	return nil
+}
+
+func (c *CommunityRepository) GetDeletedCommunity(ctx context.Context, comId string) (*storage.DeletedCommunity, error) {
+	query :=
+		`
+		SELECT c.id, c.name, c.description, c.location, c.latitude, c.longitude, c.visibility, c.created_at, c.updated_at,
+			coalesce(m.user_id::text, ''), c.deleted_at
+		FROM communities c
+		LEFT JOIN community_members m ON m.community_id = c.id AND m.role = 'owner' AND m.deleted_at = c.deleted_at
+		WHERE c.id = $1 AND c.deleted_at IS NOT NULL
+	`
+
+	community := &storage.DeletedCommunity{}
+	err := c.db.QueryRowContext(ctx, query, comId).Scan(
+		&community.ID,
+		&community.Name,
+		&community.Description,
+		&community.Location,
+		&community.Latitude,
+		&community.Longitude,
+		&community.Visibility,
+		&community.CreatedAt,
+		&community.UpdatedAt,
+		&community.OwnerID,
+		&community.DeletedAt,
+	)
+	if err != nil {
+		return nil, wrapError("community", err)
+	}
+
+	return community, nil
+}
+
+func (c *CommunityRepository) RestoreCommunity(ctx context.Context, comId, restoredBy string) (*storage.Community, error) {
+	tx, err := c.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var deletedAt time.Time
+	err = tx.QueryRowContext(ctx, `SELECT deleted_at FROM communities WHERE id = $1 AND deleted_at IS NOT NULL FOR UPDATE`, comId).Scan(&deletedAt)
+	if err != nil {
+		return nil, wrapError("community", err)
+	}
+
+	membersQuery :=
+		`
+		WITH restored AS (
+			UPDATE community_members SET deleted_at = NULL, updated_at = NOW()
+			WHERE community_id = $1 AND deleted_at = $2
+			RETURNING community_id, user_id
+		)
+		INSERT INTO community_membership_history (community_id, user_id, joined_at)
+		SELECT community_id, user_id, NOW() FROM restored
+	`
+
+	if _, err := tx.ExecContext(ctx, membersQuery, comId, deletedAt); err != nil {
+		return nil, wrapError("community member", err)
+	}
+
+	eventsQuery :=
+		`
+		UPDATE events SET deleted_at = NULL, updated_at = NOW(), cancellation_reason = NULL
+		WHERE community_id = $1 AND deleted_at = $2 AND cancellation_reason = $3
+		RETURNING id
+	`
+
+	if err := logEventChanges(ctx, tx, eventsQuery, storage.EventChangeRestored, restoredBy, comId, deletedAt, storage.CommunityDeletedReason); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE forum_posts SET archived_at = NULL WHERE community_id = $1 AND archived_at = $2`, comId, deletedAt); err != nil {
+		return nil, wrapError("forum post", err)
+	}
+
+	query :=
+		`
+		UPDATE communities SET deleted_at = NULL, updated_at = NOW()
+		WHERE id = $1
+		RETURNING id, name, description, location, latitude, longitude, visibility, created_at, updated_at
+	`
+
+	community := &storage.Community{}
+	err = tx.QueryRowContext(ctx, query, comId).Scan(&community.ID, &community.Name, &community.Description, &community.Location, &community.Latitude, &community.Longitude, &community.Visibility, &community.CreatedAt, &community.UpdatedAt)
+	if err != nil {
+		return nil, wrapError("community", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit community restore: %w", err)
+	}
+
+	return community, nil
+}
+
+// logEventChanges runs an update of events returning their ids and logs the change on
+// each of them.
+func logEventChanges(ctx context.Context, tx *sqlx.Tx, query, changeType, changedBy string, args ...interface{}) error {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return wrapError("event", err)
+	}
+
+	var eventIDs []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan event: %w", err)
+		}
+		eventIDs = append(eventIDs, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return wrapError("event", err)
+	}
+
+	for _, id := range eventIDs {
+		err := logEventChange(ctx, tx, &storage.EventChange{
+			EventID:    id,
+			ChangedBy:  changedBy,
+			ChangeType: changeType,
+			Changes:    []storage.EventFieldChange{},
+			Reason:     storage.CommunityDeletedReason,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -2,53 +2,300 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+
+	"github.com/Projects/ComunityService/storage"
+	"github.com/jmoiron/sqlx"
 )
 
-type JoinCommunity struct {
-	CommunityID string `json:"community_id,omitempty"`
-	UserID      string `json:"user_id,omitempty"`
-	JoinedAt    string `json:"joined_at,omitempty"`
-	CreatedAt   string `json:"created_at,omitempty"`
-	UpdatedAt   string `json:"updated_at,omitempty"`
-}
+// JoinCommunity adds the user to the community, reactivating their membership if they
+// left before. Each join opens a new period in community_membership_history.
+func (cs *CommunityRepository) JoinCommunity(ctx context.Context, jCom *storage.JoinCommunity) (*storage.JoinCommunity, error) {
+	tx, err := cs.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := joinCommunityTx(ctx, tx, jCom); err != nil {
+		return nil, err
+	}
 
-type LeaveCommunity struct {
-	CommunityId string `json:"community_id,omitempty"`
-	UserID      string `json:"user_id,omitempty"`
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit join community: %w", err)
+	}
+
+	return jCom, nil
 }
 
-func (cs *CommunityRepository) JoinCommunity(ctx context.Context, jCom *JoinCommunity) (*JoinCommunity, *Message) {
+// joinCommunityTx adds the membership and opens its history period within tx.
+func joinCommunityTx(ctx context.Context, tx *sqlx.Tx, jCom *storage.JoinCommunity) error {
 	query :=
 		`
-			INSERT INTO community_members (community_id, user_id, joined_at)
-            VALUES ($1, $2, NOW())
+			INSERT INTO community_members (community_id, user_id, role, joined_at)
+            VALUES ($1, $2, $3, NOW())
+            ON CONFLICT (community_id, user_id) DO UPDATE
+            SET role = EXCLUDED.role, joined_at = NOW(), updated_at = NOW(), deleted_at = NULL
+            WHERE community_members.deleted_at IS NOT NULL
             RETURNING community_id, user_id, joined_at, created_at, updated_at
         `
 
-	err := cs.db.QueryRowContext(ctx, query, jCom.CommunityID, jCom.UserID).Scan(&jCom.CommunityID, &jCom.UserID, &jCom.JoinedAt, &jCom.CreatedAt, &jCom.UpdatedAt)
+	err := tx.QueryRowContext(ctx, query, jCom.CommunityID, jCom.UserID, storage.RoleMember).Scan(&jCom.CommunityID, &jCom.UserID, &jCom.JoinedAt, &jCom.CreatedAt, &jCom.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return storage.NewError(storage.ErrConflict, "community member", "user %s is already a member of community %s", jCom.UserID, jCom.CommunityID)
+	}
+	if err != nil {
+		return wrapError("community member", err)
+	}
+
+	historyQuery :=
+		`
+		INSERT INTO community_membership_history (community_id, user_id, joined_at)
+		VALUES ($1, $2, $3)
+	`
+
+	if _, err := tx.ExecContext(ctx, historyQuery, jCom.CommunityID, jCom.UserID, jCom.JoinedAt); err != nil {
+		return wrapError("community membership history", err)
+	}
+
+	return nil
+}
+
+// LeaveCommunity soft-deletes an active membership and closes its history period.
+func (cs *CommunityRepository) LeaveCommunity(ctx context.Context, lCom *storage.LeaveCommunity) error {
+	tx, err := cs.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := leaveCommunityTx(ctx, tx, lCom.CommunityId, lCom.UserID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit leave community: %w", err)
+	}
+
+	return nil
+}
+
+// leaveCommunityTx ends the active membership and closes its history period within tx.
+// The owner row is never ended here, so a community can't lose its owner this way.
+func leaveCommunityTx(ctx context.Context, tx *sqlx.Tx, communityID, userID string) error {
+	query :=
+		`
+	    UPDATE community_members SET deleted_at = NOW(), updated_at = NOW()
+	    WHERE community_id = $1 AND user_id = $2 AND deleted_at IS NULL AND role <> 'owner'
+		`
+
+	res, err := tx.ExecContext(ctx, query, communityID, userID)
+	if err != nil {
+		return wrapError("community member", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return wrapError("community member", err)
+	}
+	if affected == 0 {
+		return storage.NewError(storage.ErrNotFound, "community member", "no member %s who can leave community %s", userID, communityID)
+	}
+
+	historyQuery :=
+		`
+		UPDATE community_membership_history SET left_at = NOW()
+		WHERE community_id = $1 AND user_id = $2 AND left_at IS NULL
+	`
+
+	if _, err := tx.ExecContext(ctx, historyQuery, communityID, userID); err != nil {
+		return wrapError("community membership history", err)
+	}
+
+	return nil
+}
+
+func (cs *CommunityRepository) IsCommunityMember(ctx context.Context, communityID, userID string) (bool, error) {
+	query :=
+		`
+		SELECT EXISTS (
+			SELECT 1 FROM community_members
+			WHERE community_id = $1 AND user_id = $2 AND deleted_at IS NULL
+		)
+	`
+
+	var isMember bool
+	if err := cs.db.QueryRowContext(ctx, query, communityID, userID).Scan(&isMember); err != nil {
+		return false, wrapError("community member", err)
+	}
+
+	return isMember, nil
+}
+
+func (cs *CommunityRepository) GetMemberRole(ctx context.Context, communityID, userID string) (string, error) {
+	query :=
+		`
+		SELECT role FROM community_members
+		WHERE community_id = $1 AND user_id = $2 AND deleted_at IS NULL
+	`
+
+	var role string
+	if err := cs.db.QueryRowContext(ctx, query, communityID, userID).Scan(&role); err != nil {
+		return "", wrapError("community member", err)
+	}
+
+	return role, nil
+}
+
+// UpdateMemberRole switches an active member between the moderator and member roles.
+// The owner row is never touched here, so a community can't lose its owner this way.
+func (cs *CommunityRepository) UpdateMemberRole(ctx context.Context, communityID, userID, role string) (*storage.CommunityMember, error) {
+	query :=
+		`
+		UPDATE community_members
+		SET role = $3, updated_at = NOW()
+		WHERE community_id = $1 AND user_id = $2 AND deleted_at IS NULL AND role <> 'owner'
+		RETURNING community_id, user_id, role, joined_at, created_at, updated_at
+	`
+
+	member := &storage.CommunityMember{}
+	err := cs.db.QueryRowContext(ctx, query, communityID, userID, role).Scan(
+		&member.CommunityID,
+		&member.UserID,
+		&member.Role,
+		&member.JoinedAt,
+		&member.CreatedAt,
+		&member.UpdatedAt,
+	)
 	if err != nil {
-		errMsg := fmt.Sprintf("Failed to join community: %v", err)
-		return nil, &Message{Error: &errMsg}
+		return nil, wrapError("community member", err)
 	}
 
-	successMsg := "Community joined successfully"
-	return jCom, &Message{Message: &successMsg}
+	return member, nil
 }
 
-func (cs *CommunityRepository) LeaveCommunity(ctx context.Context, lCom *LeaveCommunity) Message {
+func (cs *CommunityRepository) ListCommunityMembers(ctx context.Context, filter *storage.MemberListFilter) ([]*storage.CommunityMember, error) {
 	query :=
 		`
-	    UPDATE community_members SET Deleted_at = NOW() WHERE community_id = $1 and user_id = $2
+		SELECT community_id, user_id, role, joined_at, created_at, updated_at
+		FROM community_members
+		WHERE community_id = $1 AND deleted_at IS NULL
+		ORDER BY joined_at, user_id
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := cs.db.QueryContext(ctx, query, filter.CommunityID, filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, wrapError("community member", err)
+	}
+	defer rows.Close()
+
+	members := []*storage.CommunityMember{}
+	for rows.Next() {
+		member := &storage.CommunityMember{}
+		if err := rows.Scan(&member.CommunityID, &member.UserID, &member.Role, &member.JoinedAt, &member.CreatedAt, &member.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan community member: %w", err)
+		}
+		members = append(members, member)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapError("community member", err)
+	}
+
+	return members, nil
+}
+
+func (cs *CommunityRepository) ListUserCommunities(ctx context.Context, filter *storage.UserCommunityFilter) ([]*storage.UserCommunity, error) {
+	query :=
 		`
+		SELECT c.id, c.name, c.description, c.location, c.latitude, c.longitude, c.visibility, c.created_at, c.updated_at, m.role, m.joined_at
+		FROM community_members m
+		JOIN communities c ON c.id = m.community_id
+		WHERE m.user_id = $1 AND m.deleted_at IS NULL AND c.deleted_at IS NULL
+		ORDER BY m.joined_at DESC, c.id
+		LIMIT $2 OFFSET $3
+	`
 
-	_, err := cs.db.ExecContext(ctx, query, lCom.CommunityId, lCom.UserID)
+	rows, err := cs.db.QueryContext(ctx, query, filter.UserID, filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, wrapError("community member", err)
+	}
+	defer rows.Close()
+
+	communities := []*storage.UserCommunity{}
+	for rows.Next() {
+		uc := &storage.UserCommunity{}
+		if err := rows.Scan(
+			&uc.Community.ID,
+			&uc.Community.Name,
+			&uc.Community.Description,
+			&uc.Community.Location,
+			&uc.Community.Latitude,
+			&uc.Community.Longitude,
+			&uc.Community.Visibility,
+			&uc.Community.CreatedAt,
+			&uc.Community.UpdatedAt,
+			&uc.Role,
+			&uc.JoinedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan user community: %w", err)
+		}
+		communities = append(communities, uc)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapError("community member", err)
+	}
+
+	return communities, nil
+}
+
+// TransferOwnership makes newOwnerID the owner and demotes the current owner to moderator.
+// The owner row is locked first so concurrent transfers queue up behind each other.
+func (cs *CommunityRepository) TransferOwnership(ctx context.Context, communityID, newOwnerID string) (*storage.CommunityMember, *storage.CommunityMember, error) {
+	tx, err := cs.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
 
+	var ownerID string
+	err = tx.QueryRowContext(ctx, `SELECT user_id FROM community_members WHERE community_id = $1 AND role = 'owner' AND deleted_at IS NULL FOR UPDATE`, communityID).Scan(&ownerID)
 	if err != nil {
-		errMsg := fmt.Sprintf("Failed to execute the leave community query: %v", err)
-		return Message{Error: &errMsg}
+		return nil, nil, wrapError("community member", err)
+	}
+	if ownerID == newOwnerID {
+		return nil, nil, &storage.Error{Kind: storage.ErrInvalidInput, Resource: "community member", Field: "new_owner_id", Err: fmt.Errorf("user %s already owns community %s", newOwnerID, communityID)}
+	}
+
+	query :=
+		`
+		UPDATE community_members
+		SET role = $3, updated_at = NOW()
+		WHERE community_id = $1 AND user_id = $2 AND deleted_at IS NULL
+		RETURNING community_id, user_id, role, joined_at, created_at, updated_at
+	`
+
+	// The current owner is demoted first, as a community may only have one owner row.
+	previous := &storage.CommunityMember{}
+	err = tx.QueryRowContext(ctx, query, communityID, ownerID, storage.RoleModerator).Scan(&previous.CommunityID, &previous.UserID, &previous.Role, &previous.JoinedAt, &previous.CreatedAt, &previous.UpdatedAt)
+	if err != nil {
+		return nil, nil, wrapError("community member", err)
+	}
+
+	owner := &storage.CommunityMember{}
+	err = tx.QueryRowContext(ctx, query, communityID, newOwnerID, storage.RoleOwner).Scan(&owner.CommunityID, &owner.UserID, &owner.Role, &owner.JoinedAt, &owner.CreatedAt, &owner.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, storage.NewError(storage.ErrNotFound, "community member", "user %s is not a member of community %s", newOwnerID, communityID)
+	}
+	if err != nil {
+		return nil, nil, wrapError("community member", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit ownership transfer: %w", err)
 	}
 
-	successMsg := "Community left successfully"
-	return Message{Message: &successMsg}
+	return owner, previous, nil
 }
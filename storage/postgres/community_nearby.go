@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/Projects/ComunityService/storage"
+)
+
+// hasPostGIS reports whether the postgis extension is installed. The check runs once per
+// repository; if it fails the haversine fallback is used.
+func (c *CommunityRepository) hasPostGIS(ctx context.Context) bool {
+	c.postgisOnce.Do(func() {
+		query := `SELECT EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'postgis')`
+		if err := c.db.QueryRowContext(ctx, query).Scan(&c.postgis); err != nil {
+			log.Printf("failed to detect postgis, using haversine distance: %v", err)
+			c.postgis = false
+		}
+	})
+	return c.postgis
+}
+
+// FindCommunitiesNearby returns communities within filter.RadiusKm of the given point,
+// nearest first. It uses PostGIS when the extension is installed and otherwise narrows
+// candidates with a bounding box before computing the haversine distance.
+func (c *CommunityRepository) FindCommunitiesNearby(ctx context.Context, filter *storage.NearbyFilter) ([]*storage.NearbyCommunity, error) {
+	if c.hasPostGIS(ctx) {
+		return c.findNearbyPostGIS(ctx, filter)
+	}
+	return c.findNearbyHaversine(ctx, filter)
+}
+
+func (c *CommunityRepository) findNearbyPostGIS(ctx context.Context, filter *storage.NearbyFilter) ([]*storage.NearbyCommunity, error) {
+	query := fmt.Sprintf(`
+		WITH origin AS (SELECT ST_SetSRID(ST_MakePoint($2, $1), 4326)::geography AS point)
+		SELECT c.id, c.name, c.description, c.location, c.latitude, c.longitude, c.visibility, c.created_at, c.updated_at,
+			ST_Distance(ST_SetSRID(ST_MakePoint(c.longitude, c.latitude), 4326)::geography, origin.point) / 1000 AS distance_km
+		FROM communities c, origin
+		WHERE c.deleted_at IS NULL AND c.latitude IS NOT NULL
+			AND ST_DWithin(ST_SetSRID(ST_MakePoint(c.longitude, c.latitude), 4326)::geography, origin.point, $3 * 1000)
+			AND %s
+		ORDER BY distance_km, c.id
+		LIMIT $4`, visibleToCondition("c.", 5))
+
+	return c.queryNearby(ctx, query, filter.Latitude, filter.Longitude, filter.RadiusKm, filter.Limit, filter.VisibleTo)
+}
+
+func (c *CommunityRepository) findNearbyHaversine(ctx context.Context, filter *storage.NearbyFilter) ([]*storage.NearbyCommunity, error) {
+	minLat, maxLat, minLng, maxLng := storage.BoundingBox(filter.Latitude, filter.Longitude, filter.RadiusKm)
+
+	query := fmt.Sprintf(`
+		SELECT id, name, description, location, latitude, longitude, visibility, created_at, updated_at, distance_km
+		FROM (
+			SELECT id, name, description, location, latitude, longitude, visibility, created_at, updated_at,
+				2 * 6371 * asin(least(1, sqrt(
+					power(sin(radians(latitude - $1) / 2), 2) +
+					cos(radians($1)) * cos(radians(latitude)) * power(sin(radians(longitude - $2) / 2), 2)
+				))) AS distance_km
+			FROM communities
+			WHERE deleted_at IS NULL AND latitude IS NOT NULL
+				AND latitude BETWEEN $5 AND $6 AND longitude BETWEEN $7 AND $8
+				AND %s
+		) nearby
+		WHERE distance_km <= $3
+		ORDER BY distance_km, id
+		LIMIT $4`, visibleToCondition("", 9))
+
+	return c.queryNearby(ctx, query, filter.Latitude, filter.Longitude, filter.RadiusKm, filter.Limit, minLat, maxLat, minLng, maxLng, filter.VisibleTo)
+}
+
+func (c *CommunityRepository) queryNearby(ctx context.Context, query string, args ...interface{}) ([]*storage.NearbyCommunity, error) {
+	rows, err := c.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, wrapError("community", err)
+	}
+	defer rows.Close()
+
+	results := []*storage.NearbyCommunity{}
+	for rows.Next() {
+		res := &storage.NearbyCommunity{}
+		if err := rows.Scan(
+			&res.Community.ID,
+			&res.Community.Name,
+			&res.Community.Description,
+			&res.Community.Location,
+			&res.Community.Latitude,
+			&res.Community.Longitude,
+			&res.Community.Visibility,
+			&res.Community.CreatedAt,
+			&res.Community.UpdatedAt,
+			&res.DistanceKm,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan nearby community: %w", err)
+		}
+		results = append(results, res)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapError("community", err)
+	}
+
+	return results, nil
+}
@@ -2,116 +2,105 @@ package postgres
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
-	"log"
 	"strings"
+	"sync"
 	"time"
 
-	com "github.com/Projects/ComunityService/genproto/CommunityService"
-
+	"github.com/Projects/ComunityService/storage"
 	"github.com/jmoiron/sqlx"
 )
 
 type CommunityRepository struct {
 	db *sqlx.DB
-}
-
-type Community struct {
-	ID          string    `json:"id,omitempty"`
-	Name        string    `json:"name,omitempty"`
-	Description string    `json:"description,omitempty"`
-	Location    string    `json:"location,omitempty"`
-	CreatedAt   time.Time `json:"created_at,omitempty"`
-	UpdatedAt   time.Time `json:"updated_at,omitempty"`
-}
-
-type Event struct {
-	ID          string    `json:"id,omitempty"`
-	CommunityID string    `json:"community_id,omitempty"`
-	Name        string    `json:"name,omitempty"`
-	Description string    `json:"description,omitempty"`
-	EventType   string    `json:"event_type,omitempty"`
-	StartTime   time.Time `json:"start_time"`
-	EndTime     time.Time `json:"end_time"`
-	Location    string    `json:"location,omitempty"`
-	CreatedAt   time.Time `json:"created_at,omitempty"`
-	UpdatedAt   time.Time `json:"updated_at,omitempty"`
-}
-
-type CommunityUpdateFilter struct {
-	ID          *string `json:"id,omitempty"`
-	Name        *string `json:"name,omitempty"`
-	Description *string `json:"description,omitempty"`
-	Location    *string `json:"location,omitempty"`
-}
 
-type CommunityGetFilter struct {
-	Name     *string `json:"name,omitempty"`
-	Location *string `json:"location,omitempty"`
-	Limit    *int32  `json:"limit,omitempty"`
-	Offset   *int32  `json:"offset,omitempty"`
-}
-
-type Message struct {
-	Error   *string `json:"error,omitempty"`
-	Message *string `json:"message,omitempty"`
+	postgisOnce sync.Once
+	postgis     bool
 }
 
 func NewCommunityRepository(db *sqlx.DB) *CommunityRepository {
 	return &CommunityRepository{db: db}
 }
 
-func (c *CommunityRepository) CreateCommunity(ctx context.Context, community *Community) (*Community, *Message) {
+func (c *CommunityRepository) CreateCommunity(ctx context.Context, community *storage.Community, ownerID string) (*storage.Community, error) {
 	community.CreatedAt = time.Now()
 	community.UpdatedAt = time.Now()
 
+	tx, err := c.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
 	query :=
 		`
-		INSERT INTO communities (name, description, location, created_at, updated_at)
-        VALUES ($1, $2, $3, $4, $5)
-        RETURNING id, name, description, location, created_at, updated_at
+		INSERT INTO communities (name, description, location, latitude, longitude, visibility, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+        RETURNING id, name, description, location, latitude, longitude, visibility, created_at, updated_at
     `
 
-	err := c.db.QueryRowContext(ctx, query, community.Name, community.Description, community.Location, community.CreatedAt, community.UpdatedAt).Scan(
+	err = tx.QueryRowContext(ctx, query, community.Name, community.Description, community.Location, community.Latitude, community.Longitude, community.Visibility, community.CreatedAt, community.UpdatedAt).Scan(
 		&community.ID,
 		&community.Name,
 		&community.Description,
 		&community.Location,
+		&community.Latitude,
+		&community.Longitude,
+		&community.Visibility,
 		&community.CreatedAt,
 		&community.UpdatedAt,
 	)
 
 	if err != nil {
-		errMsg := fmt.Sprintf("Failed to create community: %v", err)
-		return nil, &Message{Error: &errMsg}
+		return nil, wrapError("community", err)
 	}
 
-	successMsg := "Community created successfully"
-	return community, &Message{Message: &successMsg}
+	ownerQuery :=
+		`
+		INSERT INTO community_members (community_id, user_id, role, joined_at)
+		VALUES ($1, $2, $3, NOW())
+	`
+
+	if _, err := tx.ExecContext(ctx, ownerQuery, community.ID, ownerID, storage.RoleOwner); err != nil {
+		return nil, wrapError("community member", err)
+	}
+
+	historyQuery :=
+		`
+		INSERT INTO community_membership_history (community_id, user_id, joined_at)
+		VALUES ($1, $2, NOW())
+	`
+
+	if _, err := tx.ExecContext(ctx, historyQuery, community.ID, ownerID); err != nil {
+		return nil, wrapError("community membership history", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit community: %w", err)
+	}
+
+	return community, nil
 }
 
-func (c *CommunityRepository) GetCommunity(ctx context.Context, comId string) (*Community, *Message) {
+func (c *CommunityRepository) GetCommunity(ctx context.Context, comId string) (*storage.Community, error) {
 	query :=
 		`
-		SELECT id, name, description, location, created_at, updated_at
+		SELECT id, name, description, location, latitude, longitude, visibility, created_at, updated_at
 		FROM communities 
 		WHERE deleted_at IS NULL AND id = $1
 	`
 
-	community := &Community{}
+	community := &storage.Community{}
 
-	err := c.db.QueryRowContext(ctx, query, comId).Scan(&community.ID, &community.Name, &community.Description, &community.Location, &community.CreatedAt, &community.UpdatedAt)
+	err := c.db.QueryRowContext(ctx, query, comId).Scan(&community.ID, &community.Name, &community.Description, &community.Location, &community.Latitude, &community.Longitude, &community.Visibility, &community.CreatedAt, &community.UpdatedAt)
 	if err != nil {
-		errMsg := fmt.Sprintf("Failed to get community: %v", err)
-		return nil, &Message{Error: &errMsg}
+		return nil, wrapError("community", err)
 	}
 
-	successMsg := "Community retrieved successfully"
-	return community, &Message{Message: &successMsg}
+	return community, nil
 }
 
-func (c *CommunityRepository) UpdateCommunity(ctx context.Context, com *CommunityUpdateFilter) (*Community, *Message) {
+func (c *CommunityRepository) UpdateCommunity(ctx context.Context, com *storage.CommunityUpdateFilter) (*storage.Community, error) {
 	params := []string{}
 	args := []interface{}{}
 	argIdx := 1
@@ -131,69 +120,87 @@ func (c *CommunityRepository) UpdateCommunity(ctx context.Context, com *Communit
 		args = append(args, *com.Location)
 		argIdx++
 	}
+	if com.Latitude != nil {
+		params = append(params, fmt.Sprintf("latitude = $%d", argIdx))
+		args = append(args, *com.Latitude)
+		argIdx++
+	}
+	if com.Longitude != nil {
+		params = append(params, fmt.Sprintf("longitude = $%d", argIdx))
+		args = append(args, *com.Longitude)
+		argIdx++
+	}
+	if com.Visibility != nil {
+		params = append(params, fmt.Sprintf("visibility = $%d", argIdx))
+		args = append(args, *com.Visibility)
+		argIdx++
+	}
 
 	if len(params) == 0 {
-		errMsg := "Failed to update community: no parameters to update"
-		return nil, &Message{Error: &errMsg}
+		return nil, storage.NewError(storage.ErrInvalidInput, "community", "no parameters to update")
 	}
 
 	args = append(args, *com.ID)
-	query := fmt.Sprintf("UPDATE communities SET %s, updated_at = NOW() WHERE id = $%d AND deleted_at IS NULL RETURNING id, name, description, location, created_at, updated_at", strings.Join(params, ", "), argIdx)
-	fmt.Println(query, args)
+	query := fmt.Sprintf("UPDATE communities SET %s, updated_at = NOW() WHERE id = $%d AND deleted_at IS NULL RETURNING id, name, description, location, latitude, longitude, visibility, created_at, updated_at", strings.Join(params, ", "), argIdx)
 
-	community := &Community{}
-	err := c.db.QueryRowContext(ctx, query, args...).Scan(&community.ID, &community.Name, &community.Description, &community.Location, &community.CreatedAt, &community.UpdatedAt)
+	community := &storage.Community{}
+	err := c.db.QueryRowContext(ctx, query, args...).Scan(&community.ID, &community.Name, &community.Description, &community.Location, &community.Latitude, &community.Longitude, &community.Visibility, &community.CreatedAt, &community.UpdatedAt)
 	if err != nil {
-		errMsg := fmt.Sprintf("Failed to update community: %v", err)
-		return nil, &Message{Error: &errMsg}
+		return nil, wrapError("community", err)
 	}
 
-	successMsg := "Community updated successfully"
-	return community, &Message{Message: &successMsg}
+	return community, nil
 }
 
-func (c *CommunityRepository) DeleteCommunity(ctx context.Context, comId string) *Message {
-	query :=
-		`
-        UPDATE communities
-        SET deleted_at = NOW()
-        WHERE id = $1
-    `
-	_, err := c.db.ExecContext(ctx, query, comId)
-	if err != nil {
-		errMsg := fmt.Sprintf("Failed to delete community: %v", err)
-		return &Message{Error: &errMsg}
-	}
-
-	successMsg := "Community deleted successfully"
-	return &Message{Message: &successMsg}
-}
-
-func (c *CommunityRepository) GetAllCommunities(ctx context.Context, comFilter *CommunityGetFilter) ([]*Community, *Message) {
+// communityConditions builds the WHERE conditions shared by GetAllCommunities and CountCommunities.
+func communityConditions(comFilter *storage.CommunityGetFilter) ([]string, []interface{}) {
 	params := []string{"deleted_at IS NULL"}
 	args := []interface{}{}
-	argIdx := 1
 
 	if comFilter.Name != nil {
-		params = append(params, fmt.Sprintf("name = $%d", argIdx))
 		args = append(args, *comFilter.Name)
-		argIdx++
+		params = append(params, fmt.Sprintf("name = $%d", len(args)))
 	}
 
 	if comFilter.Location != nil {
-		params = append(params, fmt.Sprintf("location = $%d", argIdx))
 		args = append(args, *comFilter.Location)
-		argIdx++
+		params = append(params, fmt.Sprintf("location = $%d", len(args)))
 	}
-	query := ""
-	if len(params) > 1 {
 
-		query = fmt.Sprintf("SELECT id, name, description, location, created_at, updated_at FROM communities WHERE %s", strings.Join(params, " AND "))
-	} else {
-		query = "SELECT id, name, description, location, created_at, updated_at FROM communities"
+	if comFilter.VisibleTo != nil {
+		if *comFilter.VisibleTo == "" {
+			params = append(params, "visibility <> 'private'")
+		} else {
+			args = append(args, *comFilter.VisibleTo)
+			params = append(params, fmt.Sprintf("(visibility <> 'private' OR id IN (SELECT community_id FROM community_members WHERE user_id = $%d AND deleted_at IS NULL))", len(args)))
+		}
+	}
 
+	return params, args
+}
+
+// visibleToCondition restricts private communities, taking the user they are visible to
+// from argument n: NULL keeps all of them, an empty ID none, and a user ID the ones that
+// user belongs to. alias qualifies the communities columns.
+func visibleToCondition(alias string, n int) string {
+	return fmt.Sprintf(`($%[2]d::text IS NULL OR %[1]svisibility <> 'private' OR %[1]sid IN (
+			SELECT community_id FROM community_members WHERE user_id::text = $%[2]d AND deleted_at IS NULL))`, alias, n)
+}
+
+// GetAllCommunities returns communities in (created_at, id) order, starting after
+// comFilter.After when it is set.
+func (c *CommunityRepository) GetAllCommunities(ctx context.Context, comFilter *storage.CommunityGetFilter) ([]*storage.Community, error) {
+	params, args := communityConditions(comFilter)
+	argIdx := len(args) + 1
+
+	if comFilter.After != nil {
+		params = append(params, fmt.Sprintf("(created_at, id) > ($%d, $%d)", argIdx, argIdx+1))
+		args = append(args, comFilter.After.CreatedAt, comFilter.After.ID)
+		argIdx += 2
 	}
 
+	query := fmt.Sprintf("SELECT id, name, description, location, latitude, longitude, visibility, created_at, updated_at FROM communities WHERE %s ORDER BY created_at, id", strings.Join(params, " AND "))
+
 	if comFilter.Limit != nil {
 		query += fmt.Sprintf(" LIMIT $%d", argIdx)
 		args = append(args, *comFilter.Limit)
@@ -206,55 +213,65 @@ func (c *CommunityRepository) GetAllCommunities(ctx context.Context, comFilter *
 		argIdx++
 	}
 
-	log.Println("query: ", query)
-	log.Println("args: ", args)
-
 	rows, err := c.db.QueryxContext(ctx, query, args...)
 	if err != nil {
-		errMsg := fmt.Sprintf("Failed to get communities: %v", err)
-		return nil, &Message{Error: &errMsg}
+		return nil, wrapError("community", err)
 	}
 	defer rows.Close()
 
-	communities := []*Community{}
+	communities := []*storage.Community{}
 	for rows.Next() {
-		var id, name, description, location string
+		var id, name, description, location, visibility string
+		var latitude, longitude *float64
 		var createdAt, updatedAt time.Time
 
-		if err := rows.Scan(&id, &name, &description, &location, &createdAt, &updatedAt); err != nil {
-			errMsg := fmt.Sprintf("Failed to scan community: %v", err)
-			return nil, &Message{Error: &errMsg}
+		if err := rows.Scan(&id, &name, &description, &location, &latitude, &longitude, &visibility, &createdAt, &updatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan community: %w", err)
 		}
 
-		community := &Community{
+		community := &storage.Community{
 			ID:          id,
 			Name:        name,
 			Description: description,
 			Location:    location,
+			Latitude:    latitude,
+			Longitude:   longitude,
+			Visibility:  visibility,
 			CreatedAt:   createdAt,
 			UpdatedAt:   updatedAt,
 		}
 		communities = append(communities, community)
 	}
-	fmt.Println(communities)
-	successMsg := "Communities retrieved successfully"
-	return communities, &Message{Message: &successMsg}
+	if err := rows.Err(); err != nil {
+		return nil, wrapError("community", err)
+	}
+	return communities, nil
 }
 
-func (c *CommunityRepository) IsValidCommunity(ctx context.Context, req *com.IsCommunityValidRequest) (*com.IsCommunityValidResponse, error) {
+func (c *CommunityRepository) CountCommunities(ctx context.Context, comFilter *storage.CommunityGetFilter) (int64, error) {
+	params, args := communityConditions(comFilter)
+	query := fmt.Sprintf("SELECT COUNT(*) FROM communities WHERE %s", strings.Join(params, " AND "))
+
+	var total int64
+	if err := c.db.QueryRowContext(ctx, query, args...).Scan(&total); err != nil {
+		return 0, wrapError("community", err)
+	}
+
+	return total, nil
+}
+
+func (c *CommunityRepository) IsValidCommunity(ctx context.Context, comId string) (bool, error) {
 	query :=
 		`
-			SLECT id FROM community WHERE deleted_at IS NOT NULL AND id = $1 
-			RETURNING id
-		`
+		SELECT EXISTS (
+			SELECT 1 FROM communities WHERE deleted_at IS NULL AND id = $1
+		)
+	`
 
-	row := c.db.QueryRowContext(ctx, query, req.Id)
-	var id string
-	if err := row.Scan(id); err != nil {
-		if err == sql.ErrNoRows {
-			return &com.IsCommunityValidResponse{Valid: false}, fmt.Errorf("no community found")
-		}
+	var valid bool
+	if err := c.db.QueryRowContext(ctx, query, comId).Scan(&valid); err != nil {
+		return false, wrapError("community", err)
 	}
 
-	return &com.IsCommunityValidResponse{Valid: true}, nil
+	return valid, nil
 }
@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Projects/ComunityService/storage"
+)
+
+// SearchCommunities ranks communities by full-text match over name and description,
+// falling back to trigram word similarity on the name so that typos still find results.
+func (c *CommunityRepository) SearchCommunities(ctx context.Context, filter *storage.CommunitySearchFilter) ([]*storage.CommunitySearchResult, error) {
+	query := fmt.Sprintf(`
+		WITH q AS (SELECT websearch_to_tsquery('english', $1) AS tsq)
+		SELECT c.id, c.name, coalesce(c.description, ''), coalesce(c.location, ''), c.latitude, c.longitude, c.visibility, c.created_at, c.updated_at,
+			ts_rank(c.search_vector, q.tsq) + word_similarity($1, c.name) AS rank,
+			ts_headline('english', c.name, q.tsq, 'StartSel=<b>, StopSel=</b>, HighlightAll=true'),
+			ts_headline('english', coalesce(c.description, ''), q.tsq, 'StartSel=<b>, StopSel=</b>, MaxWords=35, MinWords=15')
+		FROM communities c, q
+		WHERE c.deleted_at IS NULL AND (c.search_vector @@ q.tsq OR $1 <%% c.name) AND %s
+		ORDER BY rank DESC, c.id
+		LIMIT $2 OFFSET $3`, visibleToCondition("c.", 4))
+
+	rows, err := c.db.QueryContext(ctx, query, filter.Query, filter.Limit, filter.Offset, filter.VisibleTo)
+	if err != nil {
+		return nil, wrapError("community", err)
+	}
+	defer rows.Close()
+
+	results := []*storage.CommunitySearchResult{}
+	for rows.Next() {
+		res := &storage.CommunitySearchResult{}
+		if err := rows.Scan(
+			&res.Community.ID,
+			&res.Community.Name,
+			&res.Community.Description,
+			&res.Community.Location,
+			&res.Community.Latitude,
+			&res.Community.Longitude,
+			&res.Community.Visibility,
+			&res.Community.CreatedAt,
+			&res.Community.UpdatedAt,
+			&res.Rank,
+			&res.NameHighlight,
+			&res.Snippet,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan community search result: %w", err)
+		}
+		results = append(results, res)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapError("community", err)
+	}
+
+	return results, nil
+}
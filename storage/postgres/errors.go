@@ -0,0 +1,43 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Projects/ComunityService/storage"
+	"github.com/lib/pq"
+)
+
+// wrapError classifies a database error for the given resource. Errors that don't map
+// to a domain kind are returned wrapped as-is.
+func wrapError(resource string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return &storage.Error{Kind: storage.ErrNotFound, Resource: resource, Err: err}
+	}
+
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return fmt.Errorf("%s: %w", resource, err)
+	}
+
+	field := pqErr.Column
+	if field == "" {
+		field = pqErr.Constraint
+	}
+
+	switch pqErr.Code.Name() {
+	case "unique_violation", "exclusion_violation":
+		return &storage.Error{Kind: storage.ErrConflict, Resource: resource, Field: field, Err: err}
+	case "foreign_key_violation":
+		return &storage.Error{Kind: storage.ErrForeignKey, Resource: resource, Field: field, Err: err}
+	case "not_null_violation", "check_violation", "invalid_text_representation",
+		"string_data_right_truncation", "invalid_datetime_format", "datetime_field_overflow":
+		return &storage.Error{Kind: storage.ErrInvalidInput, Resource: resource, Field: field, Err: err}
+	}
+
+	return fmt.Errorf("%s: %w", resource, err)
+}
@@ -0,0 +1,287 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Projects/ComunityService/storage"
+	"github.com/jmoiron/sqlx"
+)
+
+const eventColumns = "id, community_id, name, description, type, start_time, end_time, location, latitude, longitude, capacity, coalesce(recurrence_rule, ''), coalesce(garden_id::text, ''), created_at, updated_at"
+
+func scanEvent(row interface{ Scan(...interface{}) error }, event *storage.Event) error {
+	return row.Scan(
+		&event.ID,
+		&event.CommunityID,
+		&event.Name,
+		&event.Description,
+		&event.EventType,
+		&event.StartTime,
+		&event.EndTime,
+		&event.Location,
+		&event.Latitude,
+		&event.Longitude,
+		&event.Capacity,
+		&event.RecurrenceRule,
+		&event.GardenID,
+		&event.CreatedAt,
+		&event.UpdatedAt,
+	)
+}
+
+func (c *CommunityRepository) GetEventWithCancelled(ctx context.Context, eventID string) (*storage.Event, error) {
+	query := fmt.Sprintf("SELECT %s, deleted_at FROM events WHERE id = $1", eventColumns)
+
+	event := &storage.Event{}
+	err := c.db.QueryRowContext(ctx, query, eventID).Scan(
+		&event.ID,
+		&event.CommunityID,
+		&event.Name,
+		&event.Description,
+		&event.EventType,
+		&event.StartTime,
+		&event.EndTime,
+		&event.Location,
+		&event.Latitude,
+		&event.Longitude,
+		&event.Capacity,
+		&event.RecurrenceRule,
+		&event.GardenID,
+		&event.CreatedAt,
+		&event.UpdatedAt,
+		&event.DeletedAt,
+	)
+	if err != nil {
+		return nil, wrapError("event", err)
+	}
+
+	return event, nil
+}
+
+func logEventChange(ctx context.Context, tx *sqlx.Tx, change *storage.EventChange) error {
+	changes, err := json.Marshal(change.Changes)
+	if err != nil {
+		return fmt.Errorf("failed to encode event changes: %w", err)
+	}
+
+	query :=
+		`
+		INSERT INTO event_changes (event_id, changed_by, change_type, changes, reason)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''))
+	`
+
+	if _, err := tx.ExecContext(ctx, query, change.EventID, change.ChangedBy, change.ChangeType, changes, change.Reason); err != nil {
+		return wrapError("event change", err)
+	}
+	return nil
+}
+
+func (c *CommunityRepository) UpdateCommunityEvent(ctx context.Context, update *storage.EventUpdateFilter, changedBy string) (*storage.Event, error) {
+	params := []string{}
+	args := []interface{}{}
+	argIdx := 1
+
+	if update.Name != nil {
+		params = append(params, fmt.Sprintf("name = $%d", argIdx))
+		args = append(args, *update.Name)
+		argIdx++
+	}
+	if update.Description != nil {
+		params = append(params, fmt.Sprintf("description = $%d", argIdx))
+		args = append(args, *update.Description)
+		argIdx++
+	}
+	if update.EventType != nil {
+		params = append(params, fmt.Sprintf("type = $%d", argIdx))
+		args = append(args, *update.EventType)
+		argIdx++
+	}
+	if update.StartTime != nil {
+		params = append(params, fmt.Sprintf("start_time = $%d", argIdx))
+		args = append(args, *update.StartTime)
+		argIdx++
+	}
+	if update.EndTime != nil {
+		params = append(params, fmt.Sprintf("end_time = $%d", argIdx))
+		args = append(args, *update.EndTime)
+		argIdx++
+	}
+	if update.Location != nil {
+		params = append(params, fmt.Sprintf("location = $%d", argIdx))
+		args = append(args, *update.Location)
+		argIdx++
+	}
+	if update.ClearCoordinates {
+		params = append(params, "latitude = NULL", "longitude = NULL")
+	} else {
+		if update.Latitude != nil {
+			params = append(params, fmt.Sprintf("latitude = $%d", argIdx))
+			args = append(args, *update.Latitude)
+			argIdx++
+		}
+		if update.Longitude != nil {
+			params = append(params, fmt.Sprintf("longitude = $%d", argIdx))
+			args = append(args, *update.Longitude)
+			argIdx++
+		}
+	}
+	if update.Capacity != nil {
+		params = append(params, fmt.Sprintf("capacity = NULLIF($%d, 0)", argIdx))
+		args = append(args, *update.Capacity)
+		argIdx++
+	}
+	if update.RecurrenceRule != nil {
+		params = append(params, fmt.Sprintf("recurrence_rule = NULLIF($%d, '')", argIdx))
+		args = append(args, *update.RecurrenceRule)
+		argIdx++
+	}
+	if update.GardenID != nil {
+		params = append(params, fmt.Sprintf("garden_id = NULLIF($%d, '')::uuid", argIdx))
+		args = append(args, *update.GardenID)
+		argIdx++
+	}
+
+	if len(params) == 0 {
+		return nil, storage.NewError(storage.ErrInvalidInput, "event", "no parameters to update")
+	}
+
+	tx, err := c.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	old := &storage.Event{}
+	selectQuery := fmt.Sprintf("SELECT %s FROM events WHERE deleted_at IS NULL AND id = $1 FOR UPDATE", eventColumns)
+	if err := scanEvent(tx.QueryRowContext(ctx, selectQuery, *update.ID), old); err != nil {
+		return nil, wrapError("event", err)
+	}
+
+	args = append(args, *update.ID)
+	query := fmt.Sprintf("UPDATE events SET %s, updated_at = NOW() WHERE id = $%d AND deleted_at IS NULL RETURNING %s", strings.Join(params, ", "), argIdx, eventColumns)
+
+	event := &storage.Event{}
+	if err := scanEvent(tx.QueryRowContext(ctx, query, args...), event); err != nil {
+		return nil, wrapError("event", err)
+	}
+
+	if changes := storage.DiffEvents(old, event); len(changes) > 0 {
+		err := logEventChange(ctx, tx, &storage.EventChange{
+			EventID:    event.ID,
+			ChangedBy:  changedBy,
+			ChangeType: storage.EventChangeUpdated,
+			Changes:    changes,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// A larger capacity frees seats for the waitlist, and no capacity frees them all.
+	if update.Capacity != nil && event.Capacity == nil {
+		query := `UPDATE event_rsvps SET status = 'going', updated_at = NOW() WHERE event_id = $1 AND status = 'waitlisted'`
+		if _, err := tx.ExecContext(ctx, query, event.ID); err != nil {
+			return nil, wrapError("event rsvp", err)
+		}
+	} else if update.Capacity != nil {
+		for {
+			promoted, err := promoteWaitlisted(ctx, tx, event.ID, event.Capacity)
+			if err != nil {
+				return nil, err
+			}
+			if promoted == nil {
+				break
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit event: %w", err)
+	}
+
+	return event, nil
+}
+
+func (c *CommunityRepository) DeleteCommunityEvent(ctx context.Context, eventID, changedBy, reason string) error {
+	tx, err := c.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query :=
+		`
+		UPDATE events
+		SET deleted_at = NOW(), updated_at = NOW(), cancellation_reason = NULLIF($2, '')
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	res, err := tx.ExecContext(ctx, query, eventID, reason)
+	if err != nil {
+		return wrapError("event", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return wrapError("event", err)
+	}
+	if affected == 0 {
+		return storage.NewError(storage.ErrNotFound, "event", "event %s not found", eventID)
+	}
+
+	err = logEventChange(ctx, tx, &storage.EventChange{
+		EventID:    eventID,
+		ChangedBy:  changedBy,
+		ChangeType: storage.EventChangeCancelled,
+		Changes:    []storage.EventFieldChange{},
+		Reason:     reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit event cancellation: %w", err)
+	}
+
+	return nil
+}
+
+// ListEventChanges returns the change log of an event, newest first. Cancelled events keep
+// their history.
+func (c *CommunityRepository) ListEventChanges(ctx context.Context, eventID string, limit, offset int32) ([]*storage.EventChange, error) {
+	query :=
+		`
+		SELECT id, event_id, changed_by, change_type, changes, coalesce(reason, ''), created_at
+		FROM event_changes
+		WHERE event_id = $1
+		ORDER BY created_at DESC, id
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := c.db.QueryContext(ctx, query, eventID, limit, offset)
+	if err != nil {
+		return nil, wrapError("event change", err)
+	}
+	defer rows.Close()
+
+	changes := []*storage.EventChange{}
+	for rows.Next() {
+		change := &storage.EventChange{}
+		var fields []byte
+		if err := rows.Scan(&change.ID, &change.EventID, &change.ChangedBy, &change.ChangeType, &fields, &change.Reason, &change.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan event change: %w", err)
+		}
+		if err := json.Unmarshal(fields, &change.Changes); err != nil {
+			return nil, fmt.Errorf("failed to decode event changes: %w", err)
+		}
+		changes = append(changes, change)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapError("event change", err)
+	}
+
+	return changes, nil
+}
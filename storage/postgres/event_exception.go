@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Projects/ComunityService/storage"
+)
+
+func (c *CommunityRepository) SetEventException(ctx context.Context, exception *storage.EventException) (*storage.EventException, error) {
+	query :=
+		`
+		INSERT INTO event_exceptions (event_id, original_start, cancelled, start_time, end_time)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (event_id, original_start) DO UPDATE
+		SET cancelled = EXCLUDED.cancelled,
+			start_time = EXCLUDED.start_time,
+			end_time = EXCLUDED.end_time,
+			updated_at = NOW()
+		RETURNING event_id, original_start, cancelled, start_time, end_time, created_at, updated_at
+	`
+
+	res := &storage.EventException{}
+	err := c.db.QueryRowContext(ctx, query,
+		exception.EventID,
+		exception.OriginalStart,
+		exception.Cancelled,
+		exception.StartTime,
+		exception.EndTime,
+	).Scan(
+		&res.EventID,
+		&res.OriginalStart,
+		&res.Cancelled,
+		&res.StartTime,
+		&res.EndTime,
+		&res.CreatedAt,
+		&res.UpdatedAt,
+	)
+	if err != nil {
+		return nil, wrapError("event exception", err)
+	}
+
+	return res, nil
+}
+
+func (c *CommunityRepository) ListEventExceptions(ctx context.Context, eventID string) ([]*storage.EventException, error) {
+	query :=
+		`
+		SELECT event_id, original_start, cancelled, start_time, end_time, created_at, updated_at
+		FROM event_exceptions
+		WHERE event_id = $1
+		ORDER BY original_start
+	`
+
+	rows, err := c.db.QueryContext(ctx, query, eventID)
+	if err != nil {
+		return nil, wrapError("event exception", err)
+	}
+	defer rows.Close()
+
+	exceptions := []*storage.EventException{}
+	for rows.Next() {
+		ex := &storage.EventException{}
+		if err := rows.Scan(&ex.EventID, &ex.OriginalStart, &ex.Cancelled, &ex.StartTime, &ex.EndTime, &ex.CreatedAt, &ex.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan event exception: %w", err)
+		}
+		exceptions = append(exceptions, ex)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapError("event exception", err)
+	}
+
+	return exceptions, nil
+}
@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Projects/ComunityService/storage"
+)
+
+func (c *CommunityRepository) RecordEventPlanting(ctx context.Context, planting *storage.EventPlanting) (*storage.EventPlanting, error) {
+	query :=
+		`
+		INSERT INTO event_plantings (event_id, garden_id, species, quantity, recorded_by)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`
+
+	planting.PlantID = ""
+	err := c.db.QueryRowContext(ctx, query,
+		planting.EventID,
+		planting.GardenID,
+		planting.Species,
+		planting.Quantity,
+		planting.RecordedBy,
+	).Scan(&planting.ID, &planting.CreatedAt)
+	if err != nil {
+		return nil, wrapError("event planting", err)
+	}
+
+	return planting, nil
+}
+
+func (c *CommunityRepository) CompleteEventPlanting(ctx context.Context, plantingID, plantID string) (*storage.EventPlanting, error) {
+	query :=
+		`
+		UPDATE event_plantings SET plant_id = $2
+		WHERE id = $1 AND plant_id IS NULL
+		RETURNING id, event_id, garden_id, plant_id, species, quantity, recorded_by, created_at
+	`
+
+	planting := &storage.EventPlanting{}
+	err := c.db.QueryRowContext(ctx, query, plantingID, plantID).Scan(
+		&planting.ID,
+		&planting.EventID,
+		&planting.GardenID,
+		&planting.PlantID,
+		&planting.Species,
+		&planting.Quantity,
+		&planting.RecordedBy,
+		&planting.CreatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, storage.NewError(storage.ErrNotFound, "event planting", "pending event planting %s not found", plantingID)
+	}
+	if err != nil {
+		return nil, wrapError("event planting", err)
+	}
+
+	return planting, nil
+}
+
+func (c *CommunityRepository) DeletePendingEventPlanting(ctx context.Context, plantingID string) error {
+	if _, err := c.db.ExecContext(ctx, `DELETE FROM event_plantings WHERE id = $1 AND plant_id IS NULL`, plantingID); err != nil {
+		return wrapError("event planting", err)
+	}
+	return nil
+}
+
+func (c *CommunityRepository) ListEventPlantings(ctx context.Context, eventID string) ([]*storage.EventPlanting, error) {
+	query :=
+		`
+		SELECT id, event_id, garden_id, coalesce(plant_id::text, ''), species, quantity, recorded_by, created_at
+		FROM event_plantings
+		WHERE event_id = $1
+		ORDER BY created_at, id
+	`
+
+	rows, err := c.db.QueryContext(ctx, query, eventID)
+	if err != nil {
+		return nil, wrapError("event planting", err)
+	}
+	defer rows.Close()
+
+	plantings := []*storage.EventPlanting{}
+	for rows.Next() {
+		planting := &storage.EventPlanting{}
+		if err := rows.Scan(
+			&planting.ID,
+			&planting.EventID,
+			&planting.GardenID,
+			&planting.PlantID,
+			&planting.Species,
+			&planting.Quantity,
+			&planting.RecordedBy,
+			&planting.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan event planting: %w", err)
+		}
+		plantings = append(plantings, planting)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapError("event planting", err)
+	}
+
+	return plantings, nil
+}
@@ -0,0 +1,206 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Projects/ComunityService/storage"
+	"github.com/jmoiron/sqlx"
+)
+
+// lockEvent locks the event row for the rest of the transaction so that concurrent RSVPs
+// to the same event see a consistent seat count, and returns its capacity.
+func lockEvent(ctx context.Context, tx *sqlx.Tx, eventID string) (*int32, error) {
+	query :=
+		`
+		SELECT capacity FROM events
+		WHERE deleted_at IS NULL AND id = $1
+		FOR UPDATE
+	`
+
+	var capacity *int32
+	if err := tx.QueryRowContext(ctx, query, eventID).Scan(&capacity); err != nil {
+		return nil, wrapError("event", err)
+	}
+	return capacity, nil
+}
+
+func countGoing(ctx context.Context, tx *sqlx.Tx, eventID string) (int32, error) {
+	query := `SELECT COUNT(*) FROM event_rsvps WHERE event_id = $1 AND status = 'going'`
+
+	var going int32
+	if err := tx.QueryRowContext(ctx, query, eventID).Scan(&going); err != nil {
+		return 0, wrapError("event rsvp", err)
+	}
+	return going, nil
+}
+
+// promoteWaitlisted moves the longest-waiting RSVP to going if a seat is free. It returns
+// nil when nobody was promoted.
+func promoteWaitlisted(ctx context.Context, tx *sqlx.Tx, eventID string, capacity *int32) (*storage.EventRsvp, error) {
+	if capacity == nil {
+		return nil, nil
+	}
+	going, err := countGoing(ctx, tx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if going >= *capacity {
+		return nil, nil
+	}
+
+	query :=
+		`
+		UPDATE event_rsvps SET status = 'going', updated_at = NOW()
+		WHERE (event_id, user_id) = (
+			SELECT event_id, user_id FROM event_rsvps
+			WHERE event_id = $1 AND status = 'waitlisted'
+			ORDER BY updated_at, user_id
+			LIMIT 1
+		)
+		RETURNING event_id, user_id, status, created_at, updated_at
+	`
+
+	promoted := &storage.EventRsvp{}
+	err = tx.QueryRowContext(ctx, query, eventID).Scan(&promoted.EventID, &promoted.UserID, &promoted.Status, &promoted.CreatedAt, &promoted.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, wrapError("event rsvp", err)
+	}
+	return promoted, nil
+}
+
+func (c *CommunityRepository) RsvpEvent(ctx context.Context, rsvp *storage.EventRsvp) (*storage.EventRsvp, error) {
+	tx, err := c.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	capacity, err := lockEvent(ctx, tx, rsvp.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	var previous string
+	err = tx.QueryRowContext(ctx, `SELECT status FROM event_rsvps WHERE event_id = $1 AND user_id = $2`, rsvp.EventID, rsvp.UserID).Scan(&previous)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, wrapError("event rsvp", err)
+	}
+
+	status := rsvp.Status
+	if status == storage.RsvpGoing && previous != storage.RsvpGoing && capacity != nil {
+		going, err := countGoing(ctx, tx, rsvp.EventID)
+		if err != nil {
+			return nil, err
+		}
+		if going >= *capacity {
+			status = storage.RsvpWaitlisted
+		}
+	}
+
+	// Asking for a seat again while still waitlisted keeps the place in the queue.
+	query :=
+		`
+		INSERT INTO event_rsvps (event_id, user_id, status)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (event_id, user_id) DO UPDATE
+		SET status = EXCLUDED.status,
+			updated_at = CASE WHEN event_rsvps.status = EXCLUDED.status THEN event_rsvps.updated_at ELSE NOW() END
+		RETURNING event_id, user_id, status, created_at, updated_at
+	`
+
+	res := &storage.EventRsvp{}
+	err = tx.QueryRowContext(ctx, query, rsvp.EventID, rsvp.UserID, status).Scan(&res.EventID, &res.UserID, &res.Status, &res.CreatedAt, &res.UpdatedAt)
+	if err != nil {
+		return nil, wrapError("event rsvp", err)
+	}
+
+	if previous == storage.RsvpGoing && status != storage.RsvpGoing {
+		if _, err := promoteWaitlisted(ctx, tx, rsvp.EventID, capacity); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit event rsvp: %w", err)
+	}
+
+	return res, nil
+}
+
+func (c *CommunityRepository) CancelRsvp(ctx context.Context, eventID, userID string) (*storage.EventRsvp, error) {
+	tx, err := c.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	capacity, err := lockEvent(ctx, tx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	query :=
+		`
+		DELETE FROM event_rsvps
+		WHERE event_id = $1 AND user_id = $2
+		RETURNING status
+	`
+
+	var previous string
+	if err := tx.QueryRowContext(ctx, query, eventID, userID).Scan(&previous); err != nil {
+		return nil, wrapError("event rsvp", err)
+	}
+
+	var promoted *storage.EventRsvp
+	if previous == storage.RsvpGoing {
+		promoted, err = promoteWaitlisted(ctx, tx, eventID, capacity)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit event rsvp: %w", err)
+	}
+
+	return promoted, nil
+}
+
+// ListEventAttendees returns RSVPs grouped by status in going, maybe, declined, waitlisted
+// order; the waitlist comes back in promotion order.
+func (c *CommunityRepository) ListEventAttendees(ctx context.Context, filter *storage.AttendeeListFilter) ([]*storage.EventRsvp, error) {
+	query :=
+		`
+		SELECT event_id, user_id, status, created_at, updated_at
+		FROM event_rsvps
+		WHERE event_id = $1 AND ($2 = '' OR status::text = $2)
+		ORDER BY status, updated_at, user_id
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := c.db.QueryContext(ctx, query, filter.EventID, filter.Status, filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, wrapError("event rsvp", err)
+	}
+	defer rows.Close()
+
+	attendees := []*storage.EventRsvp{}
+	for rows.Next() {
+		rsvp := &storage.EventRsvp{}
+		if err := rows.Scan(&rsvp.EventID, &rsvp.UserID, &rsvp.Status, &rsvp.CreatedAt, &rsvp.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan event rsvp: %w", err)
+		}
+		attendees = append(attendees, rsvp)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapError("event rsvp", err)
+	}
+
+	return attendees, nil
+}
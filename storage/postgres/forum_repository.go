@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Projects/ComunityService/storage"
+	"github.com/jmoiron/sqlx"
+)
+
+type ForumRepository struct {
+	db *sqlx.DB
+}
+
+func NewForumRepository(db *sqlx.DB) *ForumRepository {
+	return &ForumRepository{db: db}
+}
+
+func (f *ForumRepository) CreateForumPost(ctx context.Context, post *storage.ForumPost) (*storage.ForumPost, error) {
+	query :=
+		`
+		INSERT INTO forum_posts (community_id, user_id, title, content)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, community_id, user_id, title, content, created_at, updated_at
+	`
+
+	err := f.db.QueryRowContext(ctx, query, post.CommunityID, post.UserID, post.Title, post.Content).Scan(
+		&post.ID,
+		&post.CommunityID,
+		&post.UserID,
+		&post.Title,
+		&post.Content,
+		&post.CreatedAt,
+		&post.UpdatedAt,
+	)
+	if err != nil {
+		return nil, wrapError("forum post", err)
+	}
+
+	return post, nil
+}
+
+func (f *ForumRepository) GetForumPost(ctx context.Context, postID string) (*storage.ForumPost, error) {
+	query :=
+		`
+		SELECT id, community_id, user_id, title, content, archived_at, created_at, updated_at
+		FROM forum_posts
+		WHERE deleted_at IS NULL AND id = $1
+	`
+
+	post := &storage.ForumPost{}
+	err := f.db.QueryRowContext(ctx, query, postID).Scan(
+		&post.ID,
+		&post.CommunityID,
+		&post.UserID,
+		&post.Title,
+		&post.Content,
+		&post.ArchivedAt,
+		&post.CreatedAt,
+		&post.UpdatedAt,
+	)
+	if err != nil {
+		return nil, wrapError("forum post", err)
+	}
+
+	return post, nil
+}
+
+func (f *ForumRepository) CreateForumComment(ctx context.Context, comment *storage.ForumComment) (*storage.ForumComment, error) {
+	query :=
+		`
+		INSERT INTO forum_comments (forum_id, user_id, content)
+		VALUES ($1, $2, $3)
+		RETURNING id, forum_id, user_id, content, created_at, updated_at
+	`
+
+	err := f.db.QueryRowContext(ctx, query, comment.ForumID, comment.UserID, comment.Content).Scan(
+		&comment.ID,
+		&comment.ForumID,
+		&comment.UserID,
+		&comment.Content,
+		&comment.CreatedAt,
+		&comment.UpdatedAt,
+	)
+	if err != nil {
+		return nil, wrapError("forum comment", err)
+	}
+
+	return comment, nil
+}
+
+func (f *ForumRepository) GetForumComments(ctx context.Context, postID string) ([]*storage.ForumComment, error) {
+	query :=
+		`
+		SELECT id, forum_id, user_id, content, created_at, updated_at
+		FROM forum_comments
+		WHERE deleted_at IS NULL AND forum_id = $1
+		ORDER BY created_at, id
+	`
+
+	rows, err := f.db.QueryContext(ctx, query, postID)
+	if err != nil {
+		return nil, wrapError("forum comment", err)
+	}
+	defer rows.Close()
+
+	comments := []*storage.ForumComment{}
+	for rows.Next() {
+		comment := &storage.ForumComment{}
+		if err := rows.Scan(&comment.ID, &comment.ForumID, &comment.UserID, &comment.Content, &comment.CreatedAt, &comment.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan forum comment: %w", err)
+		}
+		comments = append(comments, comment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapError("forum comment", err)
+	}
+
+	return comments, nil
+}
@@ -0,0 +1,199 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Projects/ComunityService/storage"
+	"github.com/jmoiron/sqlx"
+)
+
+const moderationActionColumns = "id, community_id, moderator_id, target_user_id, action, coalesce(reason, ''), expires_at, created_at"
+
+type ModerationRepository struct {
+	db *sqlx.DB
+}
+
+func NewModerationRepository(db *sqlx.DB) *ModerationRepository {
+	return &ModerationRepository{db: db}
+}
+
+func scanModerationAction(row interface{ Scan(...interface{}) error }, a *storage.ModerationAction) error {
+	return row.Scan(&a.ID, &a.CommunityID, &a.ModeratorID, &a.TargetUserID, &a.Action, &a.Reason, &a.ExpiresAt, &a.CreatedAt)
+}
+
+// logActionTx appends the action to the moderation log within tx.
+func logActionTx(ctx context.Context, tx *sqlx.Tx, action *storage.ModerationAction) (*storage.ModerationAction, error) {
+	query := fmt.Sprintf(`
+		INSERT INTO moderation_log (community_id, moderator_id, target_user_id, action, reason, expires_at)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6)
+		RETURNING %s`, moderationActionColumns)
+
+	res := &storage.ModerationAction{}
+	err := scanModerationAction(tx.QueryRowContext(ctx, query,
+		action.CommunityID,
+		action.ModeratorID,
+		action.TargetUserID,
+		action.Action,
+		action.Reason,
+		action.ExpiresAt,
+	), res)
+	if err != nil {
+		return nil, wrapError("moderation action", err)
+	}
+	return res, nil
+}
+
+func (m *ModerationRepository) RemoveMember(ctx context.Context, action *storage.ModerationAction) (*storage.ModerationAction, error) {
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := leaveCommunityTx(ctx, tx, action.CommunityID, action.TargetUserID); err != nil {
+		return nil, err
+	}
+
+	res, err := logActionTx(ctx, tx, action)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit member removal: %w", err)
+	}
+
+	return res, nil
+}
+
+func (m *ModerationRepository) BanMember(ctx context.Context, action *storage.ModerationAction) (*storage.ModerationAction, error) {
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	banQuery :=
+		`
+		INSERT INTO community_bans (community_id, user_id, banned_by, reason, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (community_id, user_id) DO UPDATE
+		SET banned_by = EXCLUDED.banned_by, reason = EXCLUDED.reason, expires_at = EXCLUDED.expires_at, created_at = NOW()
+	`
+
+	if _, err := tx.ExecContext(ctx, banQuery, action.CommunityID, action.TargetUserID, action.ModeratorID, action.Reason, action.ExpiresAt); err != nil {
+		return nil, wrapError("community ban", err)
+	}
+
+	// The user may already be gone; the ban still keeps them from coming back.
+	if err := leaveCommunityTx(ctx, tx, action.CommunityID, action.TargetUserID); err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return nil, err
+	}
+
+	requestsQuery :=
+		`
+		UPDATE community_join_requests
+		SET status = $3, decided_by = $4, decided_at = NOW(), updated_at = NOW()
+		WHERE community_id = $1 AND user_id = $2 AND status = $5
+	`
+
+	if _, err := tx.ExecContext(ctx, requestsQuery, action.CommunityID, action.TargetUserID, storage.JoinRequestRejected, action.ModeratorID, storage.JoinRequestPending); err != nil {
+		return nil, wrapError("join request", err)
+	}
+
+	res, err := logActionTx(ctx, tx, action)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit member ban: %w", err)
+	}
+
+	return res, nil
+}
+
+func (m *ModerationRepository) UnbanMember(ctx context.Context, action *storage.ModerationAction) (*storage.ModerationAction, error) {
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query :=
+		`
+		DELETE FROM community_bans
+		WHERE community_id = $1 AND user_id = $2 AND (expires_at IS NULL OR expires_at > NOW())
+	`
+
+	res, err := tx.ExecContext(ctx, query, action.CommunityID, action.TargetUserID)
+	if err != nil {
+		return nil, wrapError("community ban", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return nil, wrapError("community ban", err)
+	}
+	if affected == 0 {
+		return nil, storage.NewError(storage.ErrNotFound, "community ban", "user %s is not banned from community %s", action.TargetUserID, action.CommunityID)
+	}
+
+	logged, err := logActionTx(ctx, tx, action)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit member unban: %w", err)
+	}
+
+	return logged, nil
+}
+
+func (m *ModerationRepository) GetActiveBan(ctx context.Context, communityID, userID string) (*storage.CommunityBan, error) {
+	query :=
+		`
+		SELECT community_id, user_id, banned_by, reason, expires_at, created_at
+		FROM community_bans
+		WHERE community_id = $1 AND user_id = $2 AND (expires_at IS NULL OR expires_at > NOW())
+	`
+
+	ban := &storage.CommunityBan{}
+	err := m.db.QueryRowContext(ctx, query, communityID, userID).Scan(&ban.CommunityID, &ban.UserID, &ban.BannedBy, &ban.Reason, &ban.ExpiresAt, &ban.CreatedAt)
+	if err != nil {
+		return nil, wrapError("community ban", err)
+	}
+
+	return ban, nil
+}
+
+func (m *ModerationRepository) ListModerationActions(ctx context.Context, filter *storage.ModerationLogFilter) ([]*storage.ModerationAction, error) {
+	query := fmt.Sprintf(`
+		SELECT %s FROM moderation_log
+		WHERE community_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2 OFFSET $3`, moderationActionColumns)
+
+	rows, err := m.db.QueryContext(ctx, query, filter.CommunityID, filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, wrapError("moderation action", err)
+	}
+	defer rows.Close()
+
+	actions := []*storage.ModerationAction{}
+	for rows.Next() {
+		a := &storage.ModerationAction{}
+		if err := scanModerationAction(rows, a); err != nil {
+			return nil, fmt.Errorf("failed to scan moderation action: %w", err)
+		}
+		actions = append(actions, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapError("moderation action", err)
+	}
+
+	return actions, nil
+}
@@ -0,0 +1,333 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Projects/ComunityService/storage"
+	"github.com/jmoiron/sqlx"
+)
+
+// seedListingSelect reads a listing with the quantity that is still unclaimed.
+const seedListingSelect = `
+	SELECT l.id, l.community_id, l.user_id, l.kind, l.species, l.quantity,
+		l.quantity - coalesce((
+			SELECT SUM(m.quantity) FROM seed_matches m
+			WHERE m.listing_id = l.id AND m.status IN ('accepted', 'completed')
+		), 0),
+		coalesce(l.garden_id::text, ''), coalesce(l.event_id::text, ''), coalesce(l.notes, ''),
+		l.status, l.created_at, l.updated_at
+	FROM seed_listings l`
+
+const seedMatchColumns = "id, listing_id, claimant_id, coalesce(counter_listing_id::text, ''), quantity, status, created_at, updated_at"
+
+type SeedRepository struct {
+	db *sqlx.DB
+}
+
+func NewSeedRepository(db *sqlx.DB) *SeedRepository {
+	return &SeedRepository{db: db}
+}
+
+func scanSeedListing(row interface{ Scan(...interface{}) error }, l *storage.SeedListing) error {
+	return row.Scan(
+		&l.ID,
+		&l.CommunityID,
+		&l.UserID,
+		&l.Kind,
+		&l.Species,
+		&l.Quantity,
+		&l.Available,
+		&l.GardenID,
+		&l.EventID,
+		&l.Notes,
+		&l.Status,
+		&l.CreatedAt,
+		&l.UpdatedAt,
+	)
+}
+
+func scanSeedMatch(row interface{ Scan(...interface{}) error }, m *storage.SeedMatch) error {
+	return row.Scan(&m.ID, &m.ListingID, &m.ClaimantID, &m.CounterListingID, &m.Quantity, &m.Status, &m.CreatedAt, &m.UpdatedAt)
+}
+
+func (s *SeedRepository) CreateSeedListing(ctx context.Context, listing *storage.SeedListing) (*storage.SeedListing, error) {
+	query :=
+		`
+		INSERT INTO seed_listings (community_id, user_id, kind, species, quantity, garden_id, event_id, notes)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, '')::uuid, NULLIF($7, '')::uuid, $8)
+		RETURNING id, status, created_at, updated_at
+	`
+
+	err := s.db.QueryRowContext(ctx, query,
+		listing.CommunityID,
+		listing.UserID,
+		listing.Kind,
+		listing.Species,
+		listing.Quantity,
+		listing.GardenID,
+		listing.EventID,
+		listing.Notes,
+	).Scan(&listing.ID, &listing.Status, &listing.CreatedAt, &listing.UpdatedAt)
+	if err != nil {
+		return nil, wrapError("seed listing", err)
+	}
+
+	listing.Available = listing.Quantity
+	return listing, nil
+}
+
+func (s *SeedRepository) GetSeedListing(ctx context.Context, listingID string) (*storage.SeedListing, error) {
+	listing := &storage.SeedListing{}
+	if err := scanSeedListing(s.db.QueryRowContext(ctx, seedListingSelect+" WHERE l.id = $1", listingID), listing); err != nil {
+		return nil, wrapError("seed listing", err)
+	}
+	return listing, nil
+}
+
+func (s *SeedRepository) ListSeedListings(ctx context.Context, filter *storage.SeedListingFilter) ([]*storage.SeedListing, error) {
+	params := []string{}
+	args := []interface{}{}
+
+	if filter.CommunityID != "" {
+		args = append(args, filter.CommunityID)
+		params = append(params, fmt.Sprintf("l.community_id = $%d", len(args)))
+	}
+	if filter.Kind != "" {
+		args = append(args, filter.Kind)
+		params = append(params, fmt.Sprintf("l.kind = $%d", len(args)))
+	}
+	if filter.Species != "" {
+		args = append(args, filter.Species)
+		params = append(params, fmt.Sprintf("lower(l.species) = lower($%d)", len(args)))
+	}
+	if filter.EventID != "" {
+		args = append(args, filter.EventID)
+		params = append(params, fmt.Sprintf("l.event_id = $%d", len(args)))
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		params = append(params, fmt.Sprintf("l.status = $%d", len(args)))
+	}
+
+	query := seedListingSelect
+	if len(params) > 0 {
+		query += " WHERE " + strings.Join(params, " AND ")
+	}
+	args = append(args, filter.Limit, filter.Offset)
+	query += fmt.Sprintf(" ORDER BY l.created_at DESC, l.id LIMIT $%d OFFSET $%d", len(args)-1, len(args))
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, wrapError("seed listing", err)
+	}
+	defer rows.Close()
+
+	listings := []*storage.SeedListing{}
+	for rows.Next() {
+		listing := &storage.SeedListing{}
+		if err := scanSeedListing(rows, listing); err != nil {
+			return nil, fmt.Errorf("failed to scan seed listing: %w", err)
+		}
+		listings = append(listings, listing)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapError("seed listing", err)
+	}
+
+	return listings, nil
+}
+
+func (s *SeedRepository) CloseSeedListing(ctx context.Context, listingID string) (*storage.SeedListing, error) {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `UPDATE seed_listings SET status = 'closed', updated_at = NOW() WHERE id = $1`, listingID)
+	if err != nil {
+		return nil, wrapError("seed listing", err)
+	}
+	if affected, err := res.RowsAffected(); err != nil {
+		return nil, wrapError("seed listing", err)
+	} else if affected == 0 {
+		return nil, storage.NewError(storage.ErrNotFound, "seed listing", "seed listing %s not found", listingID)
+	}
+
+	query :=
+		`
+		UPDATE seed_matches SET status = 'cancelled', updated_at = NOW()
+		WHERE listing_id = $1 AND status = 'pending'
+	`
+	if _, err := tx.ExecContext(ctx, query, listingID); err != nil {
+		return nil, wrapError("seed match", err)
+	}
+
+	listing := &storage.SeedListing{}
+	if err := scanSeedListing(tx.QueryRowContext(ctx, seedListingSelect+" WHERE l.id = $1", listingID), listing); err != nil {
+		return nil, wrapError("seed listing", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit seed listing: %w", err)
+	}
+
+	return listing, nil
+}
+
+// lockSeedListing locks the listing for the rest of the transaction and returns it.
+func lockSeedListing(ctx context.Context, tx *sqlx.Tx, listingID string) (*storage.SeedListing, error) {
+	listing := &storage.SeedListing{}
+	if err := scanSeedListing(tx.QueryRowContext(ctx, seedListingSelect+" WHERE l.id = $1 FOR UPDATE OF l", listingID), listing); err != nil {
+		return nil, wrapError("seed listing", err)
+	}
+	return listing, nil
+}
+
+func (s *SeedRepository) CreateSeedMatch(ctx context.Context, match *storage.SeedMatch) (*storage.SeedMatch, error) {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	listing, err := lockSeedListing(ctx, tx, match.ListingID)
+	if err != nil {
+		return nil, err
+	}
+	if listing.Status != storage.SeedListingOpen {
+		return nil, storage.NewError(storage.ErrConflict, "seed listing", "seed listing %s is %s", listing.ID, listing.Status)
+	}
+	if match.Quantity > listing.Available {
+		return nil, &storage.Error{Kind: storage.ErrInvalidInput, Resource: "seed match", Field: "quantity",
+			Err: fmt.Errorf("only %d of seed listing %s are available", listing.Available, listing.ID)}
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO seed_matches (listing_id, claimant_id, counter_listing_id, quantity)
+		VALUES ($1, $2, NULLIF($3, '')::uuid, $4)
+		RETURNING %s`, seedMatchColumns)
+
+	res := &storage.SeedMatch{}
+	if err := scanSeedMatch(tx.QueryRowContext(ctx, query, match.ListingID, match.ClaimantID, match.CounterListingID, match.Quantity), res); err != nil {
+		return nil, wrapError("seed match", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit seed match: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s *SeedRepository) GetSeedMatch(ctx context.Context, matchID string) (*storage.SeedMatch, error) {
+	match := &storage.SeedMatch{}
+	query := fmt.Sprintf("SELECT %s FROM seed_matches WHERE id = $1", seedMatchColumns)
+	if err := scanSeedMatch(s.db.QueryRowContext(ctx, query, matchID), match); err != nil {
+		return nil, wrapError("seed match", err)
+	}
+	return match, nil
+}
+
+func (s *SeedRepository) UpdateSeedMatchStatus(ctx context.Context, matchID, from, to string) (*storage.SeedMatch, error) {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var listingID string
+	if err := tx.QueryRowContext(ctx, `SELECT listing_id FROM seed_matches WHERE id = $1`, matchID).Scan(&listingID); err != nil {
+		return nil, wrapError("seed match", err)
+	}
+	if _, err := lockSeedListing(ctx, tx, listingID); err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE seed_matches SET status = $3, updated_at = NOW()
+		WHERE id = $1 AND status = $2
+		RETURNING %s`, seedMatchColumns)
+
+	match := &storage.SeedMatch{}
+	err = scanSeedMatch(tx.QueryRowContext(ctx, query, matchID, from, to), match)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, storage.NewError(storage.ErrConflict, "seed match", "seed match %s is no longer %s", matchID, from)
+	}
+	if err != nil {
+		return nil, wrapError("seed match", err)
+	}
+
+	// Re-read the listing so that the available quantity includes this change.
+	listing, err := lockSeedListing(ctx, tx, listingID)
+	if err != nil {
+		return nil, err
+	}
+	if listing.Available < 0 {
+		return nil, storage.NewError(storage.ErrConflict, "seed listing", "only %d of seed listing %s are left", listing.Available+match.Quantity, listingID)
+	}
+
+	if listing.Status != storage.SeedListingClosed {
+		status := storage.SeedListingOpen
+		if listing.Available == 0 {
+			status = storage.SeedListingMatched
+		}
+		if status != listing.Status {
+			if _, err := tx.ExecContext(ctx, `UPDATE seed_listings SET status = $2, updated_at = NOW() WHERE id = $1`, listingID, status); err != nil {
+				return nil, wrapError("seed listing", err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit seed match: %w", err)
+	}
+
+	return match, nil
+}
+
+// ListSeedMatches returns matches newest first.
+func (s *SeedRepository) ListSeedMatches(ctx context.Context, filter *storage.SeedMatchFilter) ([]*storage.SeedMatch, error) {
+	params := []string{}
+	args := []interface{}{}
+
+	if filter.ListingID != "" {
+		args = append(args, filter.ListingID)
+		params = append(params, fmt.Sprintf("listing_id = $%d", len(args)))
+	}
+	if filter.UserID != "" {
+		args = append(args, filter.UserID)
+		params = append(params, fmt.Sprintf("(claimant_id = $%d OR listing_id IN (SELECT id FROM seed_listings WHERE user_id = $%d))", len(args), len(args)))
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM seed_matches", seedMatchColumns)
+	if len(params) > 0 {
+		query += " WHERE " + strings.Join(params, " AND ")
+	}
+	args = append(args, filter.Limit, filter.Offset)
+	query += fmt.Sprintf(" ORDER BY created_at DESC, id LIMIT $%d OFFSET $%d", len(args)-1, len(args))
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, wrapError("seed match", err)
+	}
+	defer rows.Close()
+
+	matches := []*storage.SeedMatch{}
+	for rows.Next() {
+		match := &storage.SeedMatch{}
+		if err := scanSeedMatch(rows, match); err != nil {
+			return nil, fmt.Errorf("failed to scan seed match: %w", err)
+		}
+		matches = append(matches, match)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapError("seed match", err)
+	}
+
+	return matches, nil
+}
@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"github.com/Projects/ComunityService/storage"
+	"github.com/jmoiron/sqlx"
+)
+
+type Storage struct {
+	community  *CommunityRepository
+	forum      *ForumRepository
+	seed       *SeedRepository
+	audit      *AuditRepository
+	moderation *ModerationRepository
+}
+
+func NewStorage(db *sqlx.DB) storage.IStorage {
+	return &Storage{
+		community:  NewCommunityRepository(db),
+		forum:      NewForumRepository(db),
+		seed:       NewSeedRepository(db),
+		audit:      NewAuditRepository(db),
+		moderation: NewModerationRepository(db),
+	}
+}
+
+func (s *Storage) Community() storage.CommunityStorage {
+	return s.community
+}
+
+func (s *Storage) Member() storage.MemberStorage {
+	return s.community
+}
+
+func (s *Storage) Event() storage.EventStorage {
+	return s.community
+}
+
+func (s *Storage) Forum() storage.ForumStorage {
+	return s.forum
+}
+
+func (s *Storage) SeedExchange() storage.SeedExchangeStorage {
+	return s.seed
+}
+
+func (s *Storage) Audit() storage.AuditStorage {
+	return s.audit
+}
+
+func (s *Storage) Moderation() storage.ModerationStorage {
+	return s.moderation
+}
@@ -0,0 +1,145 @@
+package storage
+
+import (
+	"context"
+	"time"
+)
+
+// IStorage is the persistence layer used by the services. The postgres package backs it
+// with a database and the memory package keeps everything in process for tests and demos.
+type IStorage interface {
+	Community() CommunityStorage
+	Member() MemberStorage
+	Event() EventStorage
+	Forum() ForumStorage
+	SeedExchange() SeedExchangeStorage
+	Audit() AuditStorage
+	Moderation() ModerationStorage
+}
+
+type CommunityStorage interface {
+	CreateCommunity(ctx context.Context, community *Community, ownerID string) (*Community, error)
+	GetCommunity(ctx context.Context, comId string) (*Community, error)
+	UpdateCommunity(ctx context.Context, com *CommunityUpdateFilter) (*Community, error)
+	// DeleteCommunity soft-deletes the community and cascades: it closes the memberships,
+	// cancels upcoming and recurring events as deletedBy and archives the forum posts.
+	// It fails with ErrNotFound if the community doesn't exist or is already deleted.
+	DeleteCommunity(ctx context.Context, comId, deletedBy string) error
+	GetDeletedCommunity(ctx context.Context, comId string) (*DeletedCommunity, error)
+	// RestoreCommunity undoes DeleteCommunity, reopening exactly what its cascade closed.
+	RestoreCommunity(ctx context.Context, comId, restoredBy string) (*Community, error)
+	GetAllCommunities(ctx context.Context, comFilter *CommunityGetFilter) ([]*Community, error)
+	CountCommunities(ctx context.Context, comFilter *CommunityGetFilter) (int64, error)
+	IsValidCommunity(ctx context.Context, comId string) (bool, error)
+	SearchCommunities(ctx context.Context, filter *CommunitySearchFilter) ([]*CommunitySearchResult, error)
+	FindCommunitiesNearby(ctx context.Context, filter *NearbyFilter) ([]*NearbyCommunity, error)
+}
+
+type MemberStorage interface {
+	JoinCommunity(ctx context.Context, jCom *JoinCommunity) (*JoinCommunity, error)
+	LeaveCommunity(ctx context.Context, lCom *LeaveCommunity) error
+	IsCommunityMember(ctx context.Context, communityID, userID string) (bool, error)
+	GetMemberRole(ctx context.Context, communityID, userID string) (string, error)
+	UpdateMemberRole(ctx context.Context, communityID, userID, role string) (*CommunityMember, error)
+	// TransferOwnership makes the member the owner and the previous owner a moderator,
+	// returning both.
+	TransferOwnership(ctx context.Context, communityID, newOwnerID string) (*CommunityMember, *CommunityMember, error)
+	ListCommunityMembers(ctx context.Context, filter *MemberListFilter) ([]*CommunityMember, error)
+	ListUserCommunities(ctx context.Context, filter *UserCommunityFilter) ([]*UserCommunity, error)
+	// CreateJoinRequest files a pending request. It fails with ErrConflict if the user is
+	// already a member or already has a pending request for the community.
+	CreateJoinRequest(ctx context.Context, request *JoinRequest) (*JoinRequest, error)
+	GetJoinRequest(ctx context.Context, requestID string) (*JoinRequest, error)
+	ListJoinRequests(ctx context.Context, filter *JoinRequestFilter) ([]*JoinRequest, error)
+	// DecideJoinRequest approves or rejects a pending request, adding the user to the
+	// community when it is approved. It fails with ErrConflict if the request is no
+	// longer pending.
+	DecideJoinRequest(ctx context.Context, requestID, status, decidedBy string) (*JoinRequest, error)
+	CreateInvite(ctx context.Context, invite *CommunityInvite) (*CommunityInvite, error)
+	// RedeemInvite uses up one use of the community's invite and adds the user to the
+	// community. An expired or used up invite is ErrInvalidInput.
+	RedeemInvite(ctx context.Context, inviteID, communityID, userID string) (*JoinCommunity, error)
+}
+
+type EventStorage interface {
+	CreateCommunityEvent(ctx context.Context, event *Event) (*Event, error)
+	GetCommunityEvent(ctx context.Context, eventID string) (*Event, error)
+	// RsvpEvent records the user's response. A going RSVP beyond the event capacity is
+	// stored and returned as waitlisted.
+	RsvpEvent(ctx context.Context, rsvp *EventRsvp) (*EventRsvp, error)
+	// CancelRsvp removes the user's RSVP and returns the waitlisted RSVP promoted to
+	// going in its place, or nil if none was.
+	CancelRsvp(ctx context.Context, eventID, userID string) (*EventRsvp, error)
+	ListEventAttendees(ctx context.Context, filter *AttendeeListFilter) ([]*EventRsvp, error)
+	// SetEventException creates or replaces the exception for one occurrence of a recurring event.
+	SetEventException(ctx context.Context, exception *EventException) (*EventException, error)
+	ListEventExceptions(ctx context.Context, eventID string) ([]*EventException, error)
+	// ListCalendarEvents returns the community's events, including soft-deleted ones with
+	// DeletedAt set, that are recurring or end after since.
+	ListCalendarEvents(ctx context.Context, communityID string, since time.Time) ([]*Event, error)
+	ListEvents(ctx context.Context, filter *EventListFilter) ([]*Event, error)
+	// UpdateCommunityEvent applies the update and logs the changed fields as made by changedBy.
+	UpdateCommunityEvent(ctx context.Context, update *EventUpdateFilter, changedBy string) (*Event, error)
+	// DeleteCommunityEvent soft-deletes the event, recording the cancellation reason.
+	DeleteCommunityEvent(ctx context.Context, eventID, changedBy, reason string) error
+	// GetEventWithCancelled is GetCommunityEvent that also finds a cancelled event, returned
+	// with DeletedAt set.
+	GetEventWithCancelled(ctx context.Context, eventID string) (*Event, error)
+	ListEventChanges(ctx context.Context, eventID string, limit, offset int32) ([]*EventChange, error)
+	// RecordEventPlanting records a pending planting, whose PlantID is set later by
+	// CompleteEventPlanting once the plant exists in the Garden Management Service.
+	RecordEventPlanting(ctx context.Context, planting *EventPlanting) (*EventPlanting, error)
+	CompleteEventPlanting(ctx context.Context, plantingID, plantID string) (*EventPlanting, error)
+	// DeletePendingEventPlanting removes a planting whose plant could not be created.
+	DeletePendingEventPlanting(ctx context.Context, plantingID string) error
+	// ListEventPlantings returns the event's plantings in the order they were recorded.
+	ListEventPlantings(ctx context.Context, eventID string) ([]*EventPlanting, error)
+}
+
+type ForumStorage interface {
+	CreateForumPost(ctx context.Context, post *ForumPost) (*ForumPost, error)
+	GetForumPost(ctx context.Context, postID string) (*ForumPost, error)
+	CreateForumComment(ctx context.Context, comment *ForumComment) (*ForumComment, error)
+	GetForumComments(ctx context.Context, postID string) ([]*ForumComment, error)
+}
+
+type SeedExchangeStorage interface {
+	CreateSeedListing(ctx context.Context, listing *SeedListing) (*SeedListing, error)
+	GetSeedListing(ctx context.Context, listingID string) (*SeedListing, error)
+	ListSeedListings(ctx context.Context, filter *SeedListingFilter) ([]*SeedListing, error)
+	// CloseSeedListing closes the listing and cancels its pending matches.
+	CloseSeedListing(ctx context.Context, listingID string) (*SeedListing, error)
+	// CreateSeedMatch claims part of an open listing. Claiming more than is available is
+	// ErrInvalidInput and a second live claim by the same user is ErrConflict.
+	CreateSeedMatch(ctx context.Context, match *SeedMatch) (*SeedMatch, error)
+	GetSeedMatch(ctx context.Context, matchID string) (*SeedMatch, error)
+	// UpdateSeedMatchStatus moves the match from one status to another and keeps the
+	// listing's status in step with what is still available. It fails with ErrConflict
+	// if the match is no longer in the from status, or if accepting it would over-commit
+	// the listing.
+	UpdateSeedMatchStatus(ctx context.Context, matchID, from, to string) (*SeedMatch, error)
+	ListSeedMatches(ctx context.Context, filter *SeedMatchFilter) ([]*SeedMatch, error)
+}
+
+// ModerationStorage carries out moderator actions, logging each one together with its
+// effect.
+type ModerationStorage interface {
+	// RemoveMember ends the target's membership. It fails with ErrNotFound if they are
+	// not a member.
+	RemoveMember(ctx context.Context, action *ModerationAction) (*ModerationAction, error)
+	// BanMember bans the target, replacing any earlier ban, ends their membership and
+	// rejects their pending join requests.
+	BanMember(ctx context.Context, action *ModerationAction) (*ModerationAction, error)
+	// UnbanMember lifts the target's ban. It fails with ErrNotFound if they have no
+	// ban in force.
+	UnbanMember(ctx context.Context, action *ModerationAction) (*ModerationAction, error)
+	// GetActiveBan returns the user's ban in the community, or ErrNotFound if there is
+	// none or it has expired.
+	GetActiveBan(ctx context.Context, communityID, userID string) (*CommunityBan, error)
+	// ListModerationActions returns the community's moderation log, newest first.
+	ListModerationActions(ctx context.Context, filter *ModerationLogFilter) ([]*ModerationAction, error)
+}
+
+type AuditStorage interface {
+	RecordDenial(ctx context.Context, denial *AuthorizationDenial) error
+}